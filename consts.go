@@ -352,6 +352,13 @@ const (
 	NONE     = 0
 )
 
+// Polygon modes, only LINE and FILL are emulated on Mobile and Browser
+const (
+	POINT = 0x1B00
+	LINE  = 0x1B01
+	FILL  = 0x1B02
+)
+
 // GL ES 3.0 constants.
 const (
 	ACTIVE_UNIFORM_BLOCK_MAX_NAME_LENGTH          = 0x8A35
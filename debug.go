@@ -0,0 +1,61 @@
+// Copyright (c) 2019 Thomas MILLET. All rights reserved.
+
+//go:generate go run gendebug.go
+
+package gl
+
+import (
+	fmt "fmt"
+	log "log"
+	reflect "reflect"
+	strings "strings"
+)
+
+// In builds with the tgegldebug tag, every exported function of the backends is
+// wrapped to call GetError afterwards and report errors to the DebugHandler.
+// The wrappers are generated from the backends sources by gendebug.go and
+// must be generated again when a backend changes.
+
+// DebugHandler receives the GL errors raised by a function in tgegldebug builds
+type DebugHandler func(function string, args []interface{}, err Enum)
+
+// DebugLog is a DebugHandler logging errors with the standard logger, this is
+// the default handler
+func DebugLog(function string, args []interface{}, err Enum) {
+	log.Printf("GL error %s in %s\n", EnumName(err, EnumError), debugCall(function, args))
+}
+
+// DebugPanic is a DebugHandler panicking at first error
+func DebugPanic(function string, args []interface{}, err Enum) {
+	panic(fmt.Sprintf("GL error %s in %s", EnumName(err, EnumError), debugCall(function, args)))
+}
+
+var debugHandler DebugHandler = DebugLog
+
+// SetDebugHandler sets the handler called on GL errors in tgegldebug builds,
+// DebugLog, DebugPanic or any callback
+func SetDebugHandler(handler DebugHandler) {
+	debugHandler = handler
+}
+
+// debugCheck reports the errors raised by function to the DebugHandler
+func debugCheck(function string, args ...interface{}) {
+	for err := GetError(); err != NO_ERROR; err = GetError() {
+		if debugHandler != nil {
+			debugHandler(function, args, err)
+		}
+	}
+}
+
+// debugCall formats a function call, slices are only shown by their length
+func debugCall(function string, args []interface{}) string {
+	values := make([]string, len(args))
+	for i, arg := range args {
+		if v := reflect.ValueOf(arg); v.Kind() == reflect.Slice {
+			values[i] = fmt.Sprintf("%s(len=%d)", v.Type(), v.Len())
+		} else {
+			values[i] = fmt.Sprintf("%v", arg)
+		}
+	}
+	return fmt.Sprintf("%s(%s)", function, strings.Join(values, ", "))
+}
@@ -0,0 +1,45 @@
+// Copyright (c) 2019 Thomas MILLET. All rights reserved.
+
+//go:generate go run genenum.go
+
+package gl
+
+import (
+	fmt "fmt"
+)
+
+// EnumCategory selects the meaning of an Enum value in EnumName, as many GL
+// constants share the same value
+type EnumCategory int
+
+// Categories of EnumName
+const (
+	// EnumError are the codes returned by GetError
+	EnumError EnumCategory = iota
+	// EnumFramebufferStatus are the values returned by CheckFramebufferStatus
+	EnumFramebufferStatus
+	// EnumType are the data and GLSL types
+	EnumType
+	// EnumFormat are the textures and renderbuffers formats
+	EnumFormat
+	// EnumCapability are the capabilities of Enable and Disable
+	EnumCapability
+)
+
+// String implements fmt.Stringer, the name of a value shared by several
+// constants is the first one declared in consts.go, see EnumName to resolve it.
+func (e Enum) String() string {
+	if name, found := enumNames[e]; found {
+		return name
+	}
+	return fmt.Sprintf("Enum(0x%X)", uint32(e))
+}
+
+// EnumName returns the name of value in the given category, String() is used
+// if value does not belong to the category.
+func EnumName(value Enum, category EnumCategory) string {
+	if name, found := enumCategoryNames[category][value]; found {
+		return name
+	}
+	return value.String()
+}
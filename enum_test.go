@@ -0,0 +1,29 @@
+// Copyright (c) 2019 Thomas MILLET. All rights reserved.
+package gl
+
+import (
+	"testing"
+)
+
+func TestEnumName(t *testing.T) {
+	// 0 is shared by POINTS, NO_ERROR, NONE, ZERO and FALSE
+	if name := Enum(0).String(); name != "POINTS" {
+		t.Errorf("Enum(0).String() = %s, expected POINTS", name)
+	}
+	if name := EnumName(0, EnumError); name != "NO_ERROR" {
+		t.Errorf("EnumName(0, EnumError) = %s, expected NO_ERROR", name)
+	}
+	if name := EnumName(FLOAT, EnumType); name != "FLOAT" {
+		t.Errorf("EnumName(FLOAT, EnumType) = %s, expected FLOAT", name)
+	}
+	if name := EnumName(CULL_FACE, EnumCapability); name != "CULL_FACE" {
+		t.Errorf("EnumName(CULL_FACE, EnumCapability) = %s, expected CULL_FACE", name)
+	}
+	// Values outside the category fall back to String()
+	if name := EnumName(LINE, EnumCapability); name != "LINE" {
+		t.Errorf("EnumName(LINE, EnumCapability) = %s, expected LINE", name)
+	}
+	if name := Enum(0x12345).String(); name != "Enum(0x12345)" {
+		t.Errorf("Enum(0x12345).String() = %s", name)
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright (c) 2019 Thomas MILLET. All rights reserved.
+
+// +build ignore
+
+// The gendebug program generates the tgegldebug variant of each backend: a copy
+// of the backend where exported functions are renamed with a nodebug prefix,
+// followed by wrappers calling debugCheck after each of them.
+package main
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	ast "go/ast"
+	format "go/format"
+	parser "go/parser"
+	printer "go/printer"
+	token "go/token"
+	ioutil "io/ioutil"
+	log "log"
+	strings "strings"
+)
+
+var backends = map[string]string{
+	"gl_desktop.go": "gl_desktop_debug.go",
+	"gl_mobile.go":  "gl_mobile_debug.go",
+	"gl_browser.go": "gl_browser_debug.go",
+}
+
+// Functions not wrapped, GetError would consume the errors to report,
+// GetGLSLVersion makes no GL call and FlushCache only makes GL calls through
+// wrapped functions
+var excluded = map[string]bool{
+	"FlushCache":     true,
+	"GetError":       true,
+	"GetGLSLVersion": true,
+}
+
+func main() {
+	for src, dst := range backends {
+		if err := generate(src, dst); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+func generate(src, dst string) error {
+	content, err := ioutil.ReadFile(src)
+	if err != nil {
+		return err
+	}
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "// Code generated by gendebug.go from %s. DO NOT EDIT.\n\n", src)
+	for _, line := range strings.Split(string(content), "\n") {
+		if strings.HasPrefix(line, "package ") {
+			break
+		}
+		if strings.HasPrefix(line, "// +build") && line != "// +build !tgegldebug" {
+			fmt.Fprintln(buf, line)
+		}
+	}
+	fmt.Fprintf(buf, "// +build tgegldebug\n\n")
+
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, src, content, 0)
+	if err != nil {
+		return err
+	}
+
+	var wrappers []*ast.FuncDecl
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || !fn.Name.IsExported() || excluded[fn.Name.Name] {
+			continue
+		}
+		wrappers = append(wrappers, fn)
+	}
+
+	// Signatures are printed before renaming, the original declarations are reused
+	var wrappersSrc []string
+	for _, fn := range wrappers {
+		wrapperSrc, err := wrapper(fset, fn)
+		if err != nil {
+			return err
+		}
+		wrappersSrc = append(wrappersSrc, wrapperSrc)
+		fn.Name.Name = "nodebug" + fn.Name.Name
+	}
+
+	if err := printer.Fprint(buf, fset, f); err != nil {
+		return err
+	}
+	for _, wrapperSrc := range wrappersSrc {
+		fmt.Fprintf(buf, "\n%s\n", wrapperSrc)
+	}
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(dst, out, 0644)
+}
+
+// wrapper returns the source of the debug wrapper of fn
+func wrapper(fset *token.FileSet, fn *ast.FuncDecl) (string, error) {
+	var params, args []string
+	for _, field := range fn.Type.Params.List {
+		ty, err := node(fset, field.Type)
+		if err != nil {
+			return "", err
+		}
+		count := len(field.Names)
+		if count == 0 {
+			count = 1
+		}
+		for i := 0; i < count; i++ {
+			name := fmt.Sprintf("a%d", len(args))
+			params = append(params, name+" "+ty)
+			if strings.HasPrefix(ty, "...") {
+				args = append(args, name+"...")
+			} else {
+				args = append(args, name)
+			}
+		}
+	}
+
+	var results, values []string
+	if fn.Type.Results != nil {
+		for _, field := range fn.Type.Results.List {
+			ty, err := node(fset, field.Type)
+			if err != nil {
+				return "", err
+			}
+			count := len(field.Names)
+			if count == 0 {
+				count = 1
+			}
+			for i := 0; i < count; i++ {
+				results = append(results, ty)
+				values = append(values, fmt.Sprintf("r%d", len(values)))
+			}
+		}
+	}
+
+	name := fn.Name.Name
+	call := fmt.Sprintf("nodebug%s(%s)", name, strings.Join(args, ", "))
+	checkArgs := append([]string{fmt.Sprintf("%q", name)}, strings.Replace(strings.Join(args, ", "), "...", "", -1))
+	check := fmt.Sprintf("debugCheck(%s)", strings.TrimSuffix(strings.Join(checkArgs, ", "), ", "))
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "func %s(%s) ", name, strings.Join(params, ", "))
+	if len(results) > 0 {
+		fmt.Fprintf(buf, "(%s) ", strings.Join(results, ", "))
+	}
+	fmt.Fprintf(buf, "{\n")
+	if len(values) > 0 {
+		fmt.Fprintf(buf, "\t%s := %s\n\t%s\n\treturn %s\n", strings.Join(values, ", "), call, check, strings.Join(values, ", "))
+	} else {
+		fmt.Fprintf(buf, "\t%s\n\t%s\n", call, check)
+	}
+	fmt.Fprintf(buf, "}")
+	return buf.String(), nil
+}
+
+// node returns the source of an AST node
+func node(fset *token.FileSet, n ast.Node) (string, error) {
+	buf := new(bytes.Buffer)
+	if err := printer.Fprint(buf, fset, n); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
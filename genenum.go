@@ -0,0 +1,143 @@
+// Copyright (c) 2019 Thomas MILLET. All rights reserved.
+
+// +build ignore
+
+// The genenum program generates the names of the Enum values from the
+// constants declared in consts.go.
+package main
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	ast "go/ast"
+	format "go/format"
+	parser "go/parser"
+	token "go/token"
+	ioutil "io/ioutil"
+	log "log"
+	math "math"
+	sort "sort"
+	strconv "strconv"
+)
+
+// Constants of each EnumCategory
+var categories = []struct {
+	name  string
+	names []string
+}{
+	{"EnumError", []string{
+		"NO_ERROR", "INVALID_ENUM", "INVALID_VALUE", "INVALID_OPERATION", "INVALID_FRAMEBUFFER_OPERATION", "OUT_OF_MEMORY",
+	}},
+	{"EnumFramebufferStatus", []string{
+		"FRAMEBUFFER_COMPLETE", "FRAMEBUFFER_INCOMPLETE_ATTACHMENT", "FRAMEBUFFER_INCOMPLETE_MISSING_ATTACHMENT",
+		"FRAMEBUFFER_INCOMPLETE_DIMENSIONS", "FRAMEBUFFER_INCOMPLETE_MULTISAMPLE", "FRAMEBUFFER_UNSUPPORTED", "FRAMEBUFFER_UNDEFINED",
+	}},
+	{"EnumType", []string{
+		"BYTE", "UNSIGNED_BYTE", "SHORT", "UNSIGNED_SHORT", "INT", "UNSIGNED_INT", "FIXED", "FLOAT", "HALF_FLOAT",
+		"UNSIGNED_SHORT_5_6_5", "UNSIGNED_SHORT_4_4_4_4", "UNSIGNED_SHORT_5_5_5_1", "UNSIGNED_INT_2_10_10_10_REV",
+		"UNSIGNED_INT_10F_11F_11F_REV", "UNSIGNED_INT_5_9_9_9_REV", "UNSIGNED_INT_24_8", "FLOAT_32_UNSIGNED_INT_24_8_REV",
+		"INT_2_10_10_10_REV",
+		"FLOAT_VEC2", "FLOAT_VEC3", "FLOAT_VEC4", "INT_VEC2", "INT_VEC3", "INT_VEC4",
+		"UNSIGNED_INT_VEC2", "UNSIGNED_INT_VEC3", "UNSIGNED_INT_VEC4", "BOOL", "BOOL_VEC2", "BOOL_VEC3", "BOOL_VEC4",
+		"FLOAT_MAT2", "FLOAT_MAT3", "FLOAT_MAT4", "FLOAT_MAT2x3", "FLOAT_MAT2x4", "FLOAT_MAT3x2", "FLOAT_MAT3x4",
+		"FLOAT_MAT4x2", "FLOAT_MAT4x3",
+		"SAMPLER_2D", "SAMPLER_3D", "SAMPLER_CUBE", "SAMPLER_2D_SHADOW", "SAMPLER_2D_ARRAY", "SAMPLER_2D_ARRAY_SHADOW",
+		"SAMPLER_CUBE_SHADOW", "INT_SAMPLER_2D", "INT_SAMPLER_3D", "INT_SAMPLER_CUBE", "INT_SAMPLER_2D_ARRAY",
+		"UNSIGNED_INT_SAMPLER_2D", "UNSIGNED_INT_SAMPLER_3D", "UNSIGNED_INT_SAMPLER_CUBE", "UNSIGNED_INT_SAMPLER_2D_ARRAY",
+	}},
+	{"EnumFormat", []string{
+		"ALPHA", "RGB", "RGBA", "LUMINANCE", "LUMINANCE_ALPHA", "DEPTH_COMPONENT", "DEPTH_STENCIL",
+		"RED", "RG", "RED_INTEGER", "RG_INTEGER", "RGB_INTEGER", "RGBA_INTEGER",
+		"R8", "R8_SNORM", "R16F", "R32F", "R8UI", "R8I", "R16UI", "R16I", "R32UI", "R32I",
+		"RG8", "RG8_SNORM", "RG16F", "RG32F", "RG8UI", "RG8I", "RG16UI", "RG16I", "RG32UI", "RG32I",
+		"RGB8", "SRGB8", "RGB565", "RGB8_SNORM", "R11F_G11F_B10F", "RGB9_E5", "RGB16F", "RGB32F",
+		"RGB8UI", "RGB8I", "RGB16UI", "RGB16I", "RGB32UI", "RGB32I",
+		"RGBA8", "SRGB8_ALPHA8", "RGBA8_SNORM", "RGB5_A1", "RGBA4", "RGB10_A2", "RGBA16F", "RGBA32F",
+		"RGBA8UI", "RGBA8I", "RGB10_A2UI", "RGBA16UI", "RGBA16I", "RGBA32UI", "RGBA32I",
+		"DEPTH_COMPONENT16", "DEPTH_COMPONENT24", "DEPTH_COMPONENT32F", "DEPTH24_STENCIL8", "DEPTH32F_STENCIL8",
+		"STENCIL_INDEX8",
+	}},
+	{"EnumCapability", []string{
+		"BLEND", "CULL_FACE", "DEPTH_TEST", "DITHER", "POLYGON_OFFSET_FILL", "PRIMITIVE_RESTART_FIXED_INDEX",
+		"RASTERIZER_DISCARD", "SAMPLE_ALPHA_TO_COVERAGE", "SAMPLE_COVERAGE", "SCISSOR_TEST", "STENCIL_TEST",
+	}},
+}
+
+func main() {
+	fset := token.NewFileSet()
+	f, err := parser.ParseFile(fset, "consts.go", nil, 0)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// Values by name and first declared name by value
+	values := make(map[string]uint64)
+	names := make(map[uint64]string)
+	for _, decl := range f.Decls {
+		gen, ok := decl.(*ast.GenDecl)
+		if !ok || gen.Tok != token.CONST {
+			continue
+		}
+		for _, spec := range gen.Specs {
+			value := spec.(*ast.ValueSpec)
+			if len(value.Values) != 1 {
+				continue
+			}
+			lit, ok := value.Values[0].(*ast.BasicLit)
+			if !ok || lit.Kind != token.INT {
+				continue
+			}
+			v, err := strconv.ParseUint(lit.Value, 0, 64)
+			if err != nil || v > math.MaxUint32 {
+				continue
+			}
+			for _, name := range value.Names {
+				values[name.Name] = v
+				if _, found := names[v]; !found {
+					names[v] = name.Name
+				}
+			}
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	fmt.Fprintf(buf, "// Code generated by genenum.go from consts.go. DO NOT EDIT.\n\npackage gl\n\n")
+
+	sorted := make([]uint64, 0, len(names))
+	for v := range names {
+		sorted = append(sorted, v)
+	}
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	fmt.Fprintf(buf, "// First name declared in consts.go of each value\nvar enumNames = map[Enum]string{\n")
+	for _, v := range sorted {
+		fmt.Fprintf(buf, "0x%04X: %q,\n", v, names[v])
+	}
+	fmt.Fprintf(buf, "}\n\n")
+
+	fmt.Fprintf(buf, "// Names of the values of each EnumCategory\nvar enumCategoryNames = map[EnumCategory]map[Enum]string{\n")
+	for _, category := range categories {
+		fmt.Fprintf(buf, "%s: {\n", category.name)
+		seen := make(map[uint64]string)
+		for _, name := range category.names {
+			v, found := values[name]
+			if !found {
+				log.Fatalf("%s: %s not found in consts.go", category.name, name)
+			}
+			if other, found := seen[v]; found {
+				log.Fatalf("%s: %s and %s share the same value", category.name, other, name)
+			}
+			seen[v] = name
+			fmt.Fprintf(buf, "%s: %q,\n", name, name)
+		}
+		fmt.Fprintf(buf, "},\n")
+	}
+	fmt.Fprintf(buf, "}\n")
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile("enum_string.go", out, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
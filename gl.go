@@ -33,6 +33,15 @@ func (p *plugin) GetName() string {
 	return Name
 }
 
+// PollQueryResult returns the result of query q only if it is available, it never
+// waits for the GPU and ok is set to false if the result is not ready yet
+func PollQueryResult(q Query) (result uint32, ok bool) {
+	if GetQueryObjectui(q, QUERY_RESULT_AVAILABLE) == FALSE {
+		return 0, false
+	}
+	return GetQueryObjectui(q, QUERY_RESULT), true
+}
+
 // Byte buffer array singleton, allocate 1mB at startup
 var byteArrayBuffer = make([]byte, 0)
 var byteArrayBufferExtendFactor = 1
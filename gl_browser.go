@@ -1,6 +1,7 @@
 // Copyright (c) 2019 Thomas MILLET. All rights reserved.
 
 // +build js
+// +build !tgegldebug
 
 package gl
 
@@ -28,12 +29,17 @@ func (p *plugin) Init(runtime tge.Runtime) error {
 	}
 
 	programMap[NONE] = js.Null()
+	queryMap[NONE] = js.Null()
 	shaderMap[NONE] = js.Null()
+	syncMap[NONE] = js.Null()
 	bufferMap[NONE] = js.Null()
 	framebufferMap[NONE] = js.Null()
 	renderbufferMap[NONE] = js.Null()
+	samplerMap[NONE] = js.Null()
 	textureMap[NONE] = js.Null()
+	transformFeedbackMap[NONE] = js.Null()
 	vertexArrayMap[NONE] = js.Null()
+	uniformMap[Uniform(-1)] = js.Null()
 
 	return nil
 }
@@ -47,25 +53,47 @@ func GetGLSLVersion() string {
 	return "300 es"
 }
 
+// programBinarySupported indicates if GetProgramBinary and ProgramBinary are available
+const programBinarySupported = false
+
+// uniformBlockSupported indicates if GetActiveUniformsiv can report uniforms blocks
+const uniformBlockSupported = true
+
 // FlushCache free memory cache, should be called between scenes
 func FlushCache() {
+	// Before the buffers handles are released
+	flushPolygonMode()
+
 	for k := range float32TypedArrayCacheMap {
 		delete(float32TypedArrayCacheMap, k)
 	}
 	for k := range int32TypedArrayCacheMap {
 		delete(int32TypedArrayCacheMap, k)
 	}
+	for k := range uint32TypedArrayCacheMap {
+		delete(uint32TypedArrayCacheMap, k)
+	}
 
 	for k := range programMap {
 		delete(programMap, k)
 	}
 	programMapIndex = Program(1)
 
+	for k := range queryMap {
+		delete(queryMap, k)
+	}
+	queryMapIndex = Query(1)
+
 	for k := range shaderMap {
 		delete(shaderMap, k)
 	}
 	shaderMapIndex = Shader(1)
 
+	for k := range syncMap {
+		delete(syncMap, k)
+	}
+	syncMapIndex = Sync(1)
+
 	for k := range bufferMap {
 		delete(bufferMap, k)
 	}
@@ -81,24 +109,45 @@ func FlushCache() {
 	}
 	renderbufferMapIndex = Renderbuffer(1)
 
+	for k := range samplerMap {
+		delete(samplerMap, k)
+	}
+	samplerMapIndex = Sampler(1)
+
 	for k := range textureMap {
 		delete(textureMap, k)
 	}
 	textureMapIndex = Texture(1)
 
+	for k := range transformFeedbackMap {
+		delete(transformFeedbackMap, k)
+	}
+	transformFeedbackMapIndex = TransformFeedback(1)
+
 	for k := range uniformMap {
 		delete(uniformMap, k)
 	}
 	uniformMapIndex = Uniform(0)
 
+	for k := range uniformLocationMap {
+		delete(uniformLocationMap, k)
+	}
+
 	for k := range vertexArrayMap {
 		delete(vertexArrayMap, k)
 	}
 	vertexArrayMapIndex = VertexArray(1)
 
+	for k := range mappedBufferMap {
+		delete(mappedBufferMap, k)
+	}
+
 	int32ArrayBuffer = make([]int32, 0)
 	int32ArrayBufferExtendFactor = 1
 
+	uint32ArrayBuffer = make([]uint32, 0)
+	uint32ArrayBufferExtendFactor = 1
+
 	float32ArrayBuffer = make([]float32, 0)
 	float32ArrayBufferExtendFactor = 1
 
@@ -109,9 +158,15 @@ func FlushCache() {
 var programMap = make(map[Program]js.Value)
 var programMapIndex = Program(1)
 
+var queryMap = make(map[Query]js.Value)
+var queryMapIndex = Query(1)
+
 var shaderMap = make(map[Shader]js.Value)
 var shaderMapIndex = Shader(1)
 
+var syncMap = make(map[Sync]js.Value)
+var syncMapIndex = Sync(1)
+
 var bufferMap = make(map[Buffer]js.Value)
 var bufferMapIndex = Buffer(1)
 
@@ -121,15 +176,252 @@ var framebufferMapIndex = Framebuffer(1)
 var renderbufferMap = make(map[Renderbuffer]js.Value)
 var renderbufferMapIndex = Renderbuffer(1)
 
+var samplerMap = make(map[Sampler]js.Value)
+var samplerMapIndex = Sampler(1)
+
 var textureMap = make(map[Texture]js.Value)
 var textureMapIndex = Texture(1)
 
+var transformFeedbackMap = make(map[TransformFeedback]js.Value)
+var transformFeedbackMapIndex = TransformFeedback(1)
+
 var uniformMap = make(map[Uniform]js.Value)
 var uniformMapIndex = Uniform(0)
 
+// Uniforms locations by program and name, WebGL returns a new WebGLUniformLocation at each call
+var uniformLocationMap = make(map[Program]map[string]Uniform)
+
 var vertexArrayMap = make(map[VertexArray]js.Value)
 var vertexArrayMapIndex = VertexArray(1)
 
+// mappedBuffer is the shadow copy of a buffer range mapped by MapBufferRange
+type mappedBuffer struct {
+	offset int
+	access Enum
+	data   []byte
+}
+
+var mappedBufferMap = make(map[Enum]*mappedBuffer)
+
+// lookupBuffer returns the Buffer handle of a WebGLBuffer, NONE if not found
+func lookupBuffer(v js.Value) Buffer {
+	for k, b := range bufferMap {
+		if k != NONE && b == v {
+			return k
+		}
+	}
+	return NONE
+}
+
+// lookupFramebuffer returns the Framebuffer handle of a WebGLFramebuffer, NONE if not found
+func lookupFramebuffer(v js.Value) Framebuffer {
+	for k, fb := range framebufferMap {
+		if k != NONE && fb == v {
+			return k
+		}
+	}
+	return NONE
+}
+
+// lookupProgram returns the Program handle of a WebGLProgram, NONE if not found
+func lookupProgram(v js.Value) Program {
+	for k, p := range programMap {
+		if k != NONE && p == v {
+			return k
+		}
+	}
+	return NONE
+}
+
+// lookupRenderbuffer returns the Renderbuffer handle of a WebGLRenderbuffer, NONE if not found
+func lookupRenderbuffer(v js.Value) Renderbuffer {
+	for k, rb := range renderbufferMap {
+		if k != NONE && rb == v {
+			return k
+		}
+	}
+	return NONE
+}
+
+// lookupSampler returns the Sampler handle of a WebGLSampler, NONE if not found
+func lookupSampler(v js.Value) Sampler {
+	for k, sa := range samplerMap {
+		if k != NONE && sa == v {
+			return k
+		}
+	}
+	return NONE
+}
+
+// lookupShader returns the Shader handle of a WebGLShader, NONE if not found
+func lookupShader(v js.Value) Shader {
+	for k, s := range shaderMap {
+		if k != NONE && s == v {
+			return k
+		}
+	}
+	return NONE
+}
+
+// lookupTexture returns the Texture handle of a WebGLTexture, NONE if not found
+func lookupTexture(v js.Value) Texture {
+	for k, t := range textureMap {
+		if k != NONE && t == v {
+			return k
+		}
+	}
+	return NONE
+}
+
+// lookupTransformFeedback returns the TransformFeedback handle of a WebGLTransformFeedback, NONE if not found
+func lookupTransformFeedback(v js.Value) TransformFeedback {
+	for k, tf := range transformFeedbackMap {
+		if k != NONE && tf == v {
+			return k
+		}
+	}
+	return NONE
+}
+
+// lookupVertexArray returns the VertexArray handle of a WebGLVertexArrayObject, NONE if not found
+func lookupVertexArray(v js.Value) VertexArray {
+	for k, va := range vertexArrayMap {
+		if k != NONE && va == v {
+			return k
+		}
+	}
+	return NONE
+}
+
+// lookupBinding returns the handle bound to pname if pname is an object binding,
+// WebGL returns the bound object itself instead of its name
+func lookupBinding(pname Enum, v js.Value) (int, bool) {
+	switch pname {
+	case ARRAY_BUFFER_BINDING, ELEMENT_ARRAY_BUFFER_BINDING, COPY_READ_BUFFER_BINDING, COPY_WRITE_BUFFER_BINDING,
+		PIXEL_PACK_BUFFER_BINDING, PIXEL_UNPACK_BUFFER_BINDING, TRANSFORM_FEEDBACK_BUFFER_BINDING, UNIFORM_BUFFER_BINDING:
+		return int(lookupBuffer(v)), true
+	case CURRENT_PROGRAM:
+		return int(lookupProgram(v)), true
+	case FRAMEBUFFER_BINDING, READ_FRAMEBUFFER_BINDING:
+		return int(lookupFramebuffer(v)), true
+	case RENDERBUFFER_BINDING:
+		return int(lookupRenderbuffer(v)), true
+	case SAMPLER_BINDING:
+		return int(lookupSampler(v)), true
+	case TEXTURE_BINDING_2D, TEXTURE_BINDING_3D, TEXTURE_BINDING_2D_ARRAY, TEXTURE_BINDING_CUBE_MAP:
+		return int(lookupTexture(v)), true
+	case TRANSFORM_FEEDBACK_BINDING:
+		return int(lookupTransformFeedback(v)), true
+	case VERTEX_ARRAY_BINDING:
+		return int(lookupVertexArray(v)), true
+	}
+	return 0, false
+}
+
+// flushUniformLocations releases the uniforms locations of program p
+func flushUniformLocations(p Program) {
+	for _, uniformIndex := range uniformLocationMap[p] {
+		if uniformIndex.Valid() {
+			delete(uniformMap, uniformIndex)
+		}
+	}
+	delete(uniformLocationMap, p)
+}
+
+// readElementArray returns size bytes at offset of the bound element array, nil
+// if no element array is bound or the range exceeds its size
+func readElementArray(offset, size int) []byte {
+	if !Buffer(GetInteger(ELEMENT_ARRAY_BUFFER_BINDING)).Valid() || offset < 0 || offset+size > GetBufferParameteri(ELEMENT_ARRAY_BUFFER, BUFFER_SIZE) {
+		return nil
+	}
+	data := make([]byte, size)
+	GetBufferSubData(ELEMENT_ARRAY_BUFFER, offset, data)
+	return data
+}
+
+// getTexImageView returns a view of data whose type matches ty as WebGL2 requires,
+// the returned TypedArray must be released after use. False is returned if the
+// length of data is not a multiple of the size of ty.
+func getTexImageView(ty Enum, data []byte) (js.TypedArray, js.Value, bool) {
+	arrayType, size := "", 1
+	switch ty {
+	case BYTE:
+		arrayType, size = "Int8Array", 1
+	case SHORT:
+		arrayType, size = "Int16Array", 2
+	case UNSIGNED_SHORT, HALF_FLOAT, UNSIGNED_SHORT_5_6_5, UNSIGNED_SHORT_4_4_4_4, UNSIGNED_SHORT_5_5_5_1:
+		arrayType, size = "Uint16Array", 2
+	case INT:
+		arrayType, size = "Int32Array", 4
+	case UNSIGNED_INT, UNSIGNED_INT_24_8, UNSIGNED_INT_2_10_10_10_REV, UNSIGNED_INT_10F_11F_11F_REV, UNSIGNED_INT_5_9_9_9_REV:
+		arrayType, size = "Uint32Array", 4
+	case FLOAT:
+		arrayType, size = "Float32Array", 4
+	}
+	if len(data)%size != 0 {
+		fmt.Printf("WARNING: %d bytes of pixels are not a multiple of %d bytes type %s\n", len(data), size, EnumName(ty, EnumType))
+		return js.TypedArray{}, js.Null(), false
+	}
+	dataTA := js.TypedArrayOf(data)
+	if arrayType == "" {
+		return dataTA, dataTA.Value, true
+	}
+	return dataTA, js.Global().Get(arrayType).New(dataTA.Get("buffer"), dataTA.Get("byteOffset"), len(data)/size), true
+}
+
+// jsValueToFloat32s copies a JS number, boolean or array of them into dst
+func jsValueToFloat32s(dst []float32, v js.Value) {
+	switch v.Type() {
+	case js.TypeNumber:
+		dst[0] = float32(v.Float())
+	case js.TypeBoolean:
+		if v.Bool() {
+			dst[0] = TRUE
+		} else {
+			dst[0] = FALSE
+		}
+	case js.TypeObject:
+		length := v.Length()
+		for i := 0; i < length; i++ {
+			jsValueToFloat32s(dst[i:], v.Index(i))
+		}
+	}
+}
+
+// jsValueToInt32s copies a JS number, boolean or array of them into dst
+func jsValueToInt32s(dst []int32, v js.Value) {
+	switch v.Type() {
+	case js.TypeNumber:
+		dst[0] = int32(v.Int())
+	case js.TypeBoolean:
+		if v.Bool() {
+			dst[0] = TRUE
+		} else {
+			dst[0] = FALSE
+		}
+	case js.TypeObject:
+		length := v.Length()
+		for i := 0; i < length; i++ {
+			jsValueToInt32s(dst[i:], v.Index(i))
+		}
+	}
+}
+
+// jsValueToBools copies a JS boolean or array of booleans into dst
+func jsValueToBools(dst []bool, v js.Value) {
+	switch v.Type() {
+	case js.TypeBoolean:
+		dst[0] = v.Bool()
+	case js.TypeNumber:
+		dst[0] = v.Int() != 0
+	case js.TypeObject:
+		length := v.Length()
+		for i := 0; i < length; i++ {
+			jsValueToBools(dst[i:], v.Index(i))
+		}
+	}
+}
+
 func ActiveTexture(texture Enum) {
 	_pluginInstance.glContext.Call("activeTexture", int(texture))
 }
@@ -138,6 +430,14 @@ func AttachShader(p Program, s Shader) {
 	_pluginInstance.glContext.Call("attachShader", programMap[p], shaderMap[s])
 }
 
+func BeginQuery(target Enum, q Query) {
+	_pluginInstance.glContext.Call("beginQuery", int(target), queryMap[q])
+}
+
+func BeginTransformFeedback(primitiveMode Enum) {
+	_pluginInstance.glContext.Call("beginTransformFeedback", int(primitiveMode))
+}
+
 func BindAttribLocation(p Program, a Attrib, name string) {
 	_pluginInstance.glContext.Call("bindAttribLocation", programMap[p], int32(a), name)
 }
@@ -146,6 +446,14 @@ func BindBuffer(target Enum, b Buffer) {
 	_pluginInstance.glContext.Call("bindBuffer", int(target), bufferMap[b])
 }
 
+func BindBufferBase(target Enum, index uint32, b Buffer) {
+	_pluginInstance.glContext.Call("bindBufferBase", int(target), index, bufferMap[b])
+}
+
+func BindBufferRange(target Enum, index uint32, b Buffer, offset, size int) {
+	_pluginInstance.glContext.Call("bindBufferRange", int(target), index, bufferMap[b], offset, size)
+}
+
 func BindFramebuffer(target Enum, fb Framebuffer) {
 	_pluginInstance.glContext.Call("bindFramebuffer", int(target), framebufferMap[fb])
 }
@@ -154,10 +462,18 @@ func BindRenderbuffer(target Enum, rb Renderbuffer) {
 	_pluginInstance.glContext.Call("bindRenderbuffer", int(target), renderbufferMap[rb])
 }
 
+func BindSampler(unit uint32, s Sampler) {
+	_pluginInstance.glContext.Call("bindSampler", unit, samplerMap[s])
+}
+
 func BindTexture(target Enum, t Texture) {
 	_pluginInstance.glContext.Call("bindTexture", int(target), textureMap[t])
 }
 
+func BindTransformFeedback(target Enum, tf TransformFeedback) {
+	_pluginInstance.glContext.Call("bindTransformFeedback", int(target), transformFeedbackMap[tf])
+}
+
 func BindVertexArray(vao VertexArray) {
 	_pluginInstance.glContext.Call("bindVertexArray", vertexArrayMap[vao])
 }
@@ -187,6 +503,10 @@ func BufferInit(target Enum, size int, usage Enum) {
 	_pluginInstance.glContext.Call("bufferData", int(target), size, int(usage))
 }
 
+func BlitFramebuffer(srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1 int, mask, filter Enum) {
+	_pluginInstance.glContext.Call("blitFramebuffer", srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1, int(mask), int(filter))
+}
+
 func BufferData(target Enum, src []byte, usage Enum) {
 	srcTA := js.TypedArrayOf(src)
 	_pluginInstance.glContext.Call("bufferData", int(target), srcTA, int(usage))
@@ -194,7 +514,9 @@ func BufferData(target Enum, src []byte, usage Enum) {
 }
 
 func BufferSubData(target Enum, offset int, data []byte) {
-	_pluginInstance.glContext.Call("bufferSubData", int(target), offset, data)
+	dataTA := js.TypedArrayOf(data)
+	_pluginInstance.glContext.Call("bufferSubData", int(target), offset, dataTA)
+	dataTA.Release()
 }
 
 func CheckFramebufferStatus(target Enum) Enum {
@@ -205,6 +527,28 @@ func Clear(mask Enum) {
 	_pluginInstance.glContext.Call("clear", int(mask))
 }
 
+func ClearBufferfi(buffer Enum, drawbuffer int, depth float32, stencil int) {
+	_pluginInstance.glContext.Call("clearBufferfi", int(buffer), drawbuffer, depth, stencil)
+}
+
+func ClearBufferfv(buffer Enum, drawbuffer int, value []float32) {
+	valueTA := js.TypedArrayOf(value)
+	defer valueTA.Release()
+	_pluginInstance.glContext.Call("clearBufferfv", int(buffer), drawbuffer, valueTA)
+}
+
+func ClearBufferiv(buffer Enum, drawbuffer int, value []int32) {
+	valueTA := js.TypedArrayOf(value)
+	defer valueTA.Release()
+	_pluginInstance.glContext.Call("clearBufferiv", int(buffer), drawbuffer, valueTA)
+}
+
+func ClearBufferuiv(buffer Enum, drawbuffer int, value []uint32) {
+	valueTA := js.TypedArrayOf(value)
+	defer valueTA.Release()
+	_pluginInstance.glContext.Call("clearBufferuiv", int(buffer), drawbuffer, valueTA)
+}
+
 func ClearColor(red, green, blue, alpha float32) {
 	_pluginInstance.glContext.Call("clearColor", red, green, blue, alpha)
 }
@@ -217,6 +561,11 @@ func ClearStencil(s int) {
 	_pluginInstance.glContext.Call("clearStencil", s)
 }
 
+// ClientWaitSync always polls on WebGL2 as MAX_CLIENT_WAIT_TIMEOUT_WEBGL is 0 on most browsers
+func ClientWaitSync(s Sync, flags Enum, timeout uint64) Enum {
+	return Enum(_pluginInstance.glContext.Call("clientWaitSync", syncMap[s], int(flags), 0).Int())
+}
+
 func ColorMask(red, green, blue, alpha bool) {
 	_pluginInstance.glContext.Call("colorMask", red, green, blue, alpha)
 }
@@ -229,10 +578,26 @@ func CompressedTexImage2D(target Enum, level int, internalformat Enum, width, he
 	_pluginInstance.glContext.Call("compressedTexImage2D", int(target), level, internalformat, width, height, border, data)
 }
 
+func CompressedTexImage3D(target Enum, level int, internalformat Enum, width, height, depth, border int, data []byte) {
+	dataTA := js.TypedArrayOf(data)
+	defer dataTA.Release()
+	_pluginInstance.glContext.Call("compressedTexImage3D", int(target), level, int(internalformat), width, height, depth, border, dataTA)
+}
+
 func CompressedTexSubImage2D(target Enum, level, xoffset, yoffset, width, height int, format Enum, data []byte) {
 	_pluginInstance.glContext.Call("compressedTexSubImage2D", int(target), level, xoffset, yoffset, width, height, format, data)
 }
 
+func CompressedTexSubImage3D(target Enum, level, xoffset, yoffset, zoffset, width, height, depth int, format Enum, data []byte) {
+	dataTA := js.TypedArrayOf(data)
+	defer dataTA.Release()
+	_pluginInstance.glContext.Call("compressedTexSubImage3D", int(target), level, xoffset, yoffset, zoffset, width, height, depth, int(format), dataTA)
+}
+
+func CopyBufferSubData(readTarget, writeTarget Enum, readOffset, writeOffset, size int) {
+	_pluginInstance.glContext.Call("copyBufferSubData", int(readTarget), int(writeTarget), readOffset, writeOffset, size)
+}
+
 func CopyTexImage2D(target Enum, level int, internalformat Enum, x, y, width, height, border int) {
 	_pluginInstance.glContext.Call("copyTexImage2D", int(target), level, internalformat, x, y, width, height, border)
 }
@@ -241,6 +606,10 @@ func CopyTexSubImage2D(target Enum, level, xoffset, yoffset, x, y, width, height
 	_pluginInstance.glContext.Call("copyTexSubImage2D", int(target), level, xoffset, yoffset, x, y, width, height)
 }
 
+func CopyTexSubImage3D(target Enum, level, xoffset, yoffset, zoffset, x, y, width, height int) {
+	_pluginInstance.glContext.Call("copyTexSubImage3D", int(target), level, xoffset, yoffset, zoffset, x, y, width, height)
+}
+
 func CreateBuffer() Buffer {
 	bufferMap[bufferMapIndex] = _pluginInstance.glContext.Call("createBuffer")
 	buffer := Buffer(bufferMapIndex)
@@ -262,6 +631,13 @@ func CreateProgram() Program {
 	return program
 }
 
+func CreateQuery() Query {
+	queryMap[queryMapIndex] = _pluginInstance.glContext.Call("createQuery")
+	query := Query(queryMapIndex)
+	queryMapIndex++
+	return query
+}
+
 func CreateRenderbuffer() Renderbuffer {
 	renderbufferMap[renderbufferMapIndex] = _pluginInstance.glContext.Call("createRenderbuffer")
 	renderbuffer := Renderbuffer(renderbufferMapIndex)
@@ -269,6 +645,13 @@ func CreateRenderbuffer() Renderbuffer {
 	return renderbuffer
 }
 
+func CreateSampler() Sampler {
+	samplerMap[samplerMapIndex] = _pluginInstance.glContext.Call("createSampler")
+	sampler := Sampler(samplerMapIndex)
+	samplerMapIndex++
+	return sampler
+}
+
 func CreateShader(ty Enum) Shader {
 	shaderMap[shaderMapIndex] = _pluginInstance.glContext.Call("createShader", int(ty))
 	shader := Shader(shaderMapIndex)
@@ -283,6 +666,13 @@ func CreateTexture() Texture {
 	return texture
 }
 
+func CreateTransformFeedback() TransformFeedback {
+	transformFeedbackMap[transformFeedbackMapIndex] = _pluginInstance.glContext.Call("createTransformFeedback")
+	transformFeedback := TransformFeedback(transformFeedbackMapIndex)
+	transformFeedbackMapIndex++
+	return transformFeedback
+}
+
 func CreateVertexArray() VertexArray {
 	vertexArrayMap[vertexArrayMapIndex] = _pluginInstance.glContext.Call("createVertexArray")
 	vao := VertexArray(vertexArrayMapIndex)
@@ -307,6 +697,12 @@ func DeleteFramebuffer(v Framebuffer) {
 func DeleteProgram(p Program) {
 	_pluginInstance.glContext.Call("deleteProgram", programMap[p])
 	delete(programMap, p)
+	flushUniformLocations(p)
+}
+
+func DeleteQuery(v Query) {
+	_pluginInstance.glContext.Call("deleteQuery", queryMap[v])
+	delete(queryMap, v)
 }
 
 func DeleteRenderbuffer(v Renderbuffer) {
@@ -314,16 +710,31 @@ func DeleteRenderbuffer(v Renderbuffer) {
 	delete(renderbufferMap, v)
 }
 
+func DeleteSampler(v Sampler) {
+	_pluginInstance.glContext.Call("deleteSampler", samplerMap[v])
+	delete(samplerMap, v)
+}
+
 func DeleteShader(s Shader) {
 	_pluginInstance.glContext.Call("deleteShader", shaderMap[s])
 	delete(shaderMap, s)
 }
 
+func DeleteSync(s Sync) {
+	_pluginInstance.glContext.Call("deleteSync", syncMap[s])
+	delete(syncMap, s)
+}
+
 func DeleteTexture(v Texture) {
 	_pluginInstance.glContext.Call("deleteTexture", textureMap[v])
 	delete(textureMap, v)
 }
 
+func DeleteTransformFeedback(v TransformFeedback) {
+	_pluginInstance.glContext.Call("deleteTransformFeedback", transformFeedbackMap[v])
+	delete(transformFeedbackMap, v)
+}
+
 func DeleteVertexArray(v VertexArray) {
 	_pluginInstance.glContext.Call("DeleteVertexArray", vertexArrayMap[v])
 	delete(vertexArrayMap, v)
@@ -354,13 +765,37 @@ func DisableVertexAttribArray(a Attrib) {
 }
 
 func DrawArrays(mode Enum, first, count int) {
+	if isPolygonModeLine(mode) {
+		drawArraysAsLines(mode, first, count)
+		return
+	}
 	_pluginInstance.glContext.Call("drawArrays", int(mode), first, count)
 }
 
+func DrawArraysInstanced(mode Enum, first, count, primcount int) {
+	_pluginInstance.glContext.Call("drawArraysInstanced", int(mode), first, count, primcount)
+}
+
+func DrawBuffers(bufs []Enum) {
+	jsBufs := make([]interface{}, len(bufs))
+	for i, b := range bufs {
+		jsBufs[i] = int(b)
+	}
+	_pluginInstance.glContext.Call("drawBuffers", jsBufs)
+}
+
 func DrawElements(mode Enum, count int, ty Enum, offset int) {
+	if isPolygonModeLine(mode) {
+		drawElementsAsLines(mode, count, ty, offset)
+		return
+	}
 	_pluginInstance.glContext.Call("drawElements", int(mode), count, int(ty), offset)
 }
 
+func DrawElementsInstanced(mode Enum, count int, ty Enum, offset, primcount int) {
+	_pluginInstance.glContext.Call("drawElementsInstanced", int(mode), count, int(ty), offset, primcount)
+}
+
 func Enable(cap Enum) {
 	_pluginInstance.glContext.Call("enable", uint32(cap))
 }
@@ -369,6 +804,21 @@ func EnableVertexAttribArray(a Attrib) {
 	_pluginInstance.glContext.Call("enableVertexAttribArray", int32(a))
 }
 
+func EndQuery(target Enum) {
+	_pluginInstance.glContext.Call("endQuery", int(target))
+}
+
+func EndTransformFeedback() {
+	_pluginInstance.glContext.Call("endTransformFeedback")
+}
+
+func FenceSync(condition, flags Enum) Sync {
+	syncMap[syncMapIndex] = _pluginInstance.glContext.Call("fenceSync", int(condition), int(flags))
+	sync := Sync(syncMapIndex)
+	syncMapIndex++
+	return sync
+}
+
 func Finish() {
 	_pluginInstance.glContext.Call("finish")
 }
@@ -377,6 +827,12 @@ func Flush() {
 	_pluginInstance.glContext.Call("flush")
 }
 
+func FlushMappedBufferRange(target Enum, offset, length int) {
+	if mapped, found := mappedBufferMap[target]; found {
+		BufferSubData(target, mapped.offset+offset, mapped.data[offset:offset+length])
+	}
+}
+
 func FramebufferRenderbuffer(target, attachment, rbTarget Enum, rb Renderbuffer) {
 	_pluginInstance.glContext.Call("framebufferRenderbuffer", target, attachment, int(rbTarget), renderbufferMap[rb])
 }
@@ -385,6 +841,10 @@ func FramebufferTexture2D(target, attachment, texTarget Enum, t Texture, level i
 	_pluginInstance.glContext.Call("framebufferTexture2D", target, attachment, int(texTarget), textureMap[t], level)
 }
 
+func FramebufferTextureLayer(target, attachment Enum, t Texture, level, layer int) {
+	_pluginInstance.glContext.Call("framebufferTextureLayer", int(target), int(attachment), textureMap[t], level, layer)
+}
+
 func FrontFace(mode Enum) {
 	_pluginInstance.glContext.Call("frontFace", int(mode))
 }
@@ -398,14 +858,61 @@ func GetActiveAttrib(p Program, index uint32) (name string, size int, ty Enum) {
 	return ai.Get("name").String(), ai.Get("size").Int(), Enum(ai.Get("type").Int())
 }
 
+func GetActiveUniformBlockiv(dst []int32, p Program, index UniformBlock, pname Enum) {
+	result := _pluginInstance.glContext.Call("getActiveUniformBlockParameter", programMap[p], uint32(index), int(pname))
+	switch pname {
+	case UNIFORM_BLOCK_REFERENCED_BY_VERTEX_SHADER, UNIFORM_BLOCK_REFERENCED_BY_FRAGMENT_SHADER:
+		if result.Bool() {
+			dst[0] = TRUE
+		} else {
+			dst[0] = FALSE
+		}
+	case UNIFORM_BLOCK_ACTIVE_UNIFORM_INDICES:
+		length := result.Length()
+		for i := 0; i < length; i++ {
+			dst[i] = int32(result.Index(i).Int())
+		}
+	default:
+		dst[0] = int32(result.Int())
+	}
+}
+
+func GetActiveUniformBlockName(p Program, index UniformBlock) string {
+	return _pluginInstance.glContext.Call("getActiveUniformBlockName", programMap[p], uint32(index)).String()
+}
+
 func GetActiveUniform(p Program, index uint32) (name string, size int, ty Enum) {
 	ai := _pluginInstance.glContext.Call("getActiveUniform", programMap[p], index)
 	return ai.Get("name").String(), ai.Get("size").Int(), Enum(ai.Get("type").Int())
 }
 
+func GetActiveUniformsiv(dst []int32, p Program, indices []uint32, pname Enum) {
+	jsIndices := make([]interface{}, len(indices))
+	for i, index := range indices {
+		jsIndices[i] = index
+	}
+	result := _pluginInstance.glContext.Call("getActiveUniforms", programMap[p], jsIndices, int(pname))
+	length := result.Length()
+	for i := 0; i < length; i++ {
+		if pname == UNIFORM_IS_ROW_MAJOR {
+			if result.Index(i).Bool() {
+				dst[i] = TRUE
+			} else {
+				dst[i] = FALSE
+			}
+		} else {
+			dst[i] = int32(result.Index(i).Int())
+		}
+	}
+}
+
 func GetAttachedShaders(p Program) []Shader {
-	fmt.Printf("WARNING: GetAttachedShaders not implemented\n")
-	return []Shader{}
+	result := _pluginInstance.glContext.Call("getAttachedShaders", programMap[p])
+	shaders := make([]Shader, result.Length())
+	for i := range shaders {
+		shaders[i] = lookupShader(result.Index(i))
+	}
+	return shaders
 }
 
 func GetAttribLocation(p Program, name string) Attrib {
@@ -414,49 +921,72 @@ func GetAttribLocation(p Program, name string) Attrib {
 
 func GetBooleanv(dst []bool, pname Enum) {
 	result := _pluginInstance.glContext.Call("getParameter", int(pname))
-	length := result.Length()
-	for i := 0; i < length; i++ {
-		dst[i] = result.Index(i).Bool()
+	if handle, found := lookupBinding(pname, result); found {
+		dst[0] = handle != NONE
+		return
 	}
+	jsValueToBools(dst, result)
 }
 
 func GetFloatv(dst []float32, pname Enum) {
 	result := _pluginInstance.glContext.Call("getParameter", int(pname))
-	length := result.Length()
-	for i := 0; i < length; i++ {
-		dst[i] = float32(result.Index(i).Float())
+	if handle, found := lookupBinding(pname, result); found {
+		dst[0] = float32(handle)
+		return
 	}
+	jsValueToFloat32s(dst, result)
 }
 
 func GetIntegerv(pname Enum, data []int32) {
 	result := _pluginInstance.glContext.Call("getParameter", int(pname))
-	length := result.Length()
-	for i := 0; i < length; i++ {
-		data[i] = int32(result.Index(i).Int())
+	if handle, found := lookupBinding(pname, result); found {
+		data[0] = int32(handle)
+		return
 	}
+	jsValueToInt32s(data, result)
 }
 
 func GetInteger(pname Enum) int {
-	return _pluginInstance.glContext.Call("getParameter", int(pname)).Int()
+	result := _pluginInstance.glContext.Call("getParameter", int(pname))
+	if handle, found := lookupBinding(pname, result); found {
+		return handle
+	}
+	var data [1]int32
+	jsValueToInt32s(data[:], result)
+	return int(data[0])
 }
 
 func GetBufferParameteri(target, pname Enum) int {
 	return _pluginInstance.glContext.Call("getBufferParameter", int(target), int(pname)).Int()
 }
 
+func GetBufferSubData(target Enum, offset int, dst []byte) {
+	dstTA := js.TypedArrayOf(dst)
+	_pluginInstance.glContext.Call("getBufferSubData", int(target), offset, dstTA)
+	dstTA.Release()
+}
+
 func GetError() Enum {
 	return Enum(_pluginInstance.glContext.Call("getError").Int())
 }
 
 func GetBoundFramebuffer() Framebuffer {
-	fmt.Printf("WARNING: GetAttachedShaders not implemented\n")
-	return NONE
+	return lookupFramebuffer(_pluginInstance.glContext.Call("getParameter", FRAMEBUFFER_BINDING))
+}
+
+func GetFragDataLocation(p Program, name string) int {
+	return _pluginInstance.glContext.Call("getFragDataLocation", programMap[p], name).Int()
 }
 
 func GetFramebufferAttachmentParameteri(target, attachment, pname Enum) int {
 	return _pluginInstance.glContext.Call("getFramebufferAttachmentParameter", int(target), int(attachment), int(pname)).Int()
 }
 
+func GetProgramBinary(p Program) (data []byte, format Enum) {
+	fmt.Printf("WARNING: GetProgramBinary not implemented\n")
+	return nil, NONE
+}
+
 func GetProgrami(p Program, pname Enum) int {
 	switch pname {
 	case DELETE_STATUS, LINK_STATUS, VALIDATE_STATUS:
@@ -473,10 +1003,43 @@ func GetProgramInfoLog(p Program) string {
 	return _pluginInstance.glContext.Call("getProgramInfoLog", programMap[p]).String()
 }
 
+func GetQueryi(target, pname Enum) int {
+	result := _pluginInstance.glContext.Call("getQuery", int(target), int(pname))
+	if pname == CURRENT_QUERY {
+		for k, v := range queryMap {
+			if k != NONE && v == result {
+				return int(k)
+			}
+		}
+		return NONE
+	}
+	return result.Int()
+}
+
+// GetQueryObjectui never blocks on WebGL2, results are only made available between frames
+func GetQueryObjectui(q Query, pname Enum) uint32 {
+	result := _pluginInstance.glContext.Call("getQueryParameter", queryMap[q], int(pname))
+	if pname == QUERY_RESULT_AVAILABLE {
+		if result.Bool() {
+			return TRUE
+		}
+		return FALSE
+	}
+	return uint32(result.Int())
+}
+
 func GetRenderbufferParameteri(target, pname Enum) int {
 	return _pluginInstance.glContext.Call("getRenderbufferParameter", int(target), int(pname)).Int()
 }
 
+func GetSamplerParameterfv(dst []float32, s Sampler, pname Enum) {
+	dst[0] = float32(_pluginInstance.glContext.Call("getSamplerParameter", samplerMap[s], int(pname)).Float())
+}
+
+func GetSamplerParameteriv(dst []int32, s Sampler, pname Enum) {
+	dst[0] = int32(_pluginInstance.glContext.Call("getSamplerParameter", samplerMap[s], int(pname)).Int())
+}
+
 func GetShaderi(s Shader, pname Enum) int {
 	switch pname {
 	case DELETE_STATUS, COMPILE_STATUS:
@@ -509,66 +1072,124 @@ func GetString(pname Enum) string {
 	return _pluginInstance.glContext.Call("getParameter", int(pname)).String()
 }
 
+func GetSynciv(dst []int32, s Sync, pname Enum) {
+	dst[0] = int32(_pluginInstance.glContext.Call("getSyncParameter", syncMap[s], int(pname)).Int())
+}
+
 func GetTexParameterfv(dst []float32, target, pname Enum) {
-	dst[0] = float32(_pluginInstance.glContext.Call("getTexParameter", int(pname)).Float())
+	jsValueToFloat32s(dst, _pluginInstance.glContext.Call("getTexParameter", int(target), int(pname)))
 }
 
 func GetTexParameteriv(dst []int32, target, pname Enum) {
-	dst[0] = int32(_pluginInstance.glContext.Call("getTexParameter", int(pname)).Int())
+	jsValueToInt32s(dst, _pluginInstance.glContext.Call("getTexParameter", int(target), int(pname)))
+}
+
+func GetTransformFeedbackVarying(p Program, index uint32) (name string, size int, ty Enum) {
+	ai := _pluginInstance.glContext.Call("getTransformFeedbackVarying", programMap[p], index)
+	return ai.Get("name").String(), ai.Get("size").Int(), Enum(ai.Get("type").Int())
 }
 
 func GetUniformfv(dst []float32, src Uniform, p Program) {
-	result := _pluginInstance.glContext.Call("getUniform")
-	length := result.Length()
-	for i := 0; i < length; i++ {
-		dst[i] = float32(result.Index(i).Float())
-	}
+	jsValueToFloat32s(dst, _pluginInstance.glContext.Call("getUniform", programMap[p], uniformMap[src]))
 }
 
 func GetUniformiv(dst []int32, src Uniform, p Program) {
-	result := _pluginInstance.glContext.Call("getUniform")
-	length := result.Length()
-	for i := 0; i < length; i++ {
-		dst[i] = int32(result.Index(i).Int())
+	jsValueToInt32s(dst, _pluginInstance.glContext.Call("getUniform", programMap[p], uniformMap[src]))
+}
+
+func GetUniformBlockIndex(p Program, name string) UniformBlock {
+	return UniformBlock(uint32(_pluginInstance.glContext.Call("getUniformBlockIndex", programMap[p], name).Int()))
+}
+
+func GetUniformIndices(p Program, names []string) []uint32 {
+	jsNames := make([]interface{}, len(names))
+	for i, n := range names {
+		jsNames[i] = n
+	}
+	indices := make([]uint32, len(names))
+	result := _pluginInstance.glContext.Call("getUniformIndices", programMap[p], jsNames)
+	for i := range indices {
+		indices[i] = uint32(result.Index(i).Int())
 	}
+	return indices
 }
 
+// GetUniformLocation returns the same Uniform for the same program and name until the
+// program is linked again, Uniform(-1) if name is not an active uniform
 func GetUniformLocation(p Program, name string) Uniform {
-	uniform := _pluginInstance.glContext.Call("getUniformLocation", programMap[p], name)
-	uniformIndex := *(*Uniform)(unsafe.Pointer(&uniform))
-	uniformMap[uniformIndex] = uniform
-	return Uniform(uniformIndex)
+	locations, found := uniformLocationMap[p]
+	if !found {
+		locations = make(map[string]Uniform)
+		uniformLocationMap[p] = locations
+	}
+	if uniformIndex, found := locations[name]; found {
+		return uniformIndex
+	}
 
+	uniformIndex := Uniform(-1)
+	if uniform := _pluginInstance.glContext.Call("getUniformLocation", programMap[p], name); uniform != js.Null() {
+		uniformIndex = uniformMapIndex
+		uniformMap[uniformIndex] = uniform
+		uniformMapIndex++
+	}
+	locations[name] = uniformIndex
+	return uniformIndex
 }
 
 func GetVertexAttribf(src Attrib, pname Enum) float32 {
-	return float32(_pluginInstance.glContext.Call("getVertexAttrib", int32(src), int(pname)).Float())
+	var dst [4]float32
+	GetVertexAttribfv(dst[:], src, pname)
+	return dst[0]
 }
 
 func GetVertexAttribfv(dst []float32, src Attrib, pname Enum) {
-	result := _pluginInstance.glContext.Call("getVertexAttrib")
-	length := result.Length()
-	for i := 0; i < length; i++ {
-		dst[i] = float32(result.Index(i).Float())
+	result := _pluginInstance.glContext.Call("getVertexAttrib", int32(src), int(pname))
+	if pname == VERTEX_ATTRIB_ARRAY_BUFFER_BINDING {
+		dst[0] = float32(lookupBuffer(result))
+	} else {
+		jsValueToFloat32s(dst, result)
 	}
 }
 
 func GetVertexAttribi(src Attrib, pname Enum) int32 {
-	return int32(_pluginInstance.glContext.Call("getVertexAttrib", int32(src), int(pname)).Int())
+	var dst [4]int32
+	GetVertexAttribiv(dst[:], src, pname)
+	return dst[0]
 }
 
 func GetVertexAttribiv(dst []int32, src Attrib, pname Enum) {
-	result := _pluginInstance.glContext.Call("getVertexAttrib")
-	length := result.Length()
-	for i := 0; i < length; i++ {
-		dst[i] = int32(result.Index(i).Int())
+	result := _pluginInstance.glContext.Call("getVertexAttrib", int32(src), int(pname))
+	if pname == VERTEX_ATTRIB_ARRAY_BUFFER_BINDING {
+		dst[0] = int32(lookupBuffer(result))
+	} else {
+		jsValueToInt32s(dst, result)
 	}
 }
 
+func GetVertexAttribIiv(dst []int32, src Attrib, pname Enum) {
+	GetVertexAttribiv(dst, src, pname)
+}
+
 func Hint(target, mode Enum) {
 	_pluginInstance.glContext.Call("hint", int(target), int(mode))
 }
 
+func InvalidateFramebuffer(target Enum, attachments []Enum) {
+	jsAttachments := make([]interface{}, len(attachments))
+	for i, a := range attachments {
+		jsAttachments[i] = int(a)
+	}
+	_pluginInstance.glContext.Call("invalidateFramebuffer", int(target), jsAttachments)
+}
+
+func InvalidateSubFramebuffer(target Enum, attachments []Enum, x, y, width, height int) {
+	jsAttachments := make([]interface{}, len(attachments))
+	for i, a := range attachments {
+		jsAttachments[i] = int(a)
+	}
+	_pluginInstance.glContext.Call("invalidateSubFramebuffer", int(target), jsAttachments, x, y, width, height)
+}
+
 func IsBuffer(b Buffer) bool {
 	if buffer, found := bufferMap[b]; found {
 		return _pluginInstance.glContext.Call("isBuffer", buffer).Bool()
@@ -620,9 +1241,27 @@ func LineWidth(width float32) {
 }
 
 func LinkProgram(p Program) {
+	flushUniformLocations(p)
 	_pluginInstance.glContext.Call("linkProgram", programMap[p])
 }
 
+// MapBufferRange is emulated on WebGL2 with a shadow buffer uploaded with bufferSubData
+// on FlushMappedBufferRange or UnmapBuffer, the buffer must stay bound to target meanwhile
+func MapBufferRange(target Enum, offset, length int, access Enum) []byte {
+	mapped := &mappedBuffer{offset: offset, access: access, data: make([]byte, length)}
+	if access&MAP_READ_BIT != 0 {
+		dataTA := js.TypedArrayOf(mapped.data)
+		_pluginInstance.glContext.Call("getBufferSubData", int(target), offset, dataTA)
+		dataTA.Release()
+	}
+	mappedBufferMap[target] = mapped
+	return mapped.data
+}
+
+func PauseTransformFeedback() {
+	_pluginInstance.glContext.Call("pauseTransformFeedback")
+}
+
 func PixelStorei(pname Enum, param int32) {
 	_pluginInstance.glContext.Call("pixelStorei", int(pname), param)
 }
@@ -631,8 +1270,21 @@ func PolygonOffset(factor, units float32) {
 	_pluginInstance.glContext.Call("polygonOffset", factor, units)
 }
 
+// PolygonMode LINE is emulated on WebGL2 by drawing the edges of triangles with LINES
 func PolygonMode(face, mode Enum) {
-	fmt.Printf("WARNING: PolygonMode not implemented\n")
+	setPolygonMode(mode)
+}
+
+func ProgramBinary(p Program, format Enum, data []byte) {
+	fmt.Printf("WARNING: ProgramBinary not implemented\n")
+}
+
+func ProgramParameteri(p Program, pname Enum, value int) {
+	fmt.Printf("WARNING: ProgramParameteri not implemented\n")
+}
+
+func ReadBuffer(src Enum) {
+	_pluginInstance.glContext.Call("readBuffer", int(src))
 }
 
 func ReadPixels(dst []byte, x, y, width, height int, format, ty Enum) {
@@ -655,10 +1307,38 @@ func RenderbufferStorage(target, internalFormat Enum, width, height int) {
 	_pluginInstance.glContext.Call("renderbufferStorage", target, uint32(internalFormat), width, height)
 }
 
+func RenderbufferStorageMultisample(target Enum, samples int, internalFormat Enum, width, height int) {
+	_pluginInstance.glContext.Call("renderbufferStorageMultisample", int(target), samples, int(internalFormat), width, height)
+}
+
+func ResumeTransformFeedback() {
+	_pluginInstance.glContext.Call("resumeTransformFeedback")
+}
+
 func SampleCoverage(value float32, invert bool) {
 	_pluginInstance.glContext.Call("sampleCoverage", value, invert)
 }
 
+func SamplerParameterf(s Sampler, pname Enum, param float32) {
+	_pluginInstance.glContext.Call("samplerParameterf", samplerMap[s], int(pname), param)
+}
+
+func SamplerParameterfv(s Sampler, pname Enum, params []float32) {
+	for _, param := range params {
+		_pluginInstance.glContext.Call("samplerParameterf", samplerMap[s], int(pname), param)
+	}
+}
+
+func SamplerParameteri(s Sampler, pname Enum, param int) {
+	_pluginInstance.glContext.Call("samplerParameteri", samplerMap[s], int(pname), param)
+}
+
+func SamplerParameteriv(s Sampler, pname Enum, params []int32) {
+	for _, param := range params {
+		_pluginInstance.glContext.Call("samplerParameteri", samplerMap[s], int(pname), param)
+	}
+}
+
 func Scissor(x, y, width, height int32) {
 	_pluginInstance.glContext.Call("scissor", x, y, width, height)
 }
@@ -694,15 +1374,58 @@ func StencilOpSeparate(face, sfail, dpfail, dppass Enum) {
 func TexImage2D(target Enum, level int, width, height int, format Enum, ty Enum, data []byte) {
 	var p interface{}
 	if data != nil {
-		dataTA := js.TypedArrayOf(data)
+		dataTA, view, ok := getTexImageView(ty, data)
+		if !ok {
+			return
+		}
 		defer dataTA.Release()
-		p = dataTA
+		p = view
 	}
 	_pluginInstance.glContext.Call("texImage2D", int(target), level, int(format), width, height, 0, int(format), int(ty), p)
 }
 
+func TexImage2DInternal(target Enum, level int, internalFormat Enum, width, height int, format Enum, ty Enum, data []byte) {
+	var p interface{}
+	if data != nil {
+		dataTA, view, ok := getTexImageView(ty, data)
+		if !ok {
+			return
+		}
+		defer dataTA.Release()
+		p = view
+	}
+	_pluginInstance.glContext.Call("texImage2D", int(target), level, int(internalFormat), width, height, 0, int(format), int(ty), p)
+}
+
+func TexImage3D(target Enum, level int, width, height, depth int, format Enum, ty Enum, data []byte) {
+	var p interface{}
+	if data != nil {
+		dataTA, view, ok := getTexImageView(ty, data)
+		if !ok {
+			return
+		}
+		defer dataTA.Release()
+		p = view
+	}
+	_pluginInstance.glContext.Call("texImage3D", int(target), level, int(format), width, height, depth, 0, int(format), int(ty), p)
+}
+
 func TexSubImage2D(target Enum, level int, x, y, width, height int, format, ty Enum, data []byte) {
-	_pluginInstance.glContext.Call("texSubImage2D", int(target), level, x, y, width, height, format, int(ty), data)
+	dataTA, view, ok := getTexImageView(ty, data)
+	if !ok {
+		return
+	}
+	defer dataTA.Release()
+	_pluginInstance.glContext.Call("texSubImage2D", int(target), level, x, y, width, height, int(format), int(ty), view)
+}
+
+func TexSubImage3D(target Enum, level int, x, y, z, width, height, depth int, format, ty Enum, data []byte) {
+	dataTA, view, ok := getTexImageView(ty, data)
+	if !ok {
+		return
+	}
+	defer dataTA.Release()
+	_pluginInstance.glContext.Call("texSubImage3D", int(target), level, x, y, z, width, height, depth, int(format), int(ty), view)
 }
 
 func TexParameterf(target, pname Enum, param float32) {
@@ -725,6 +1448,22 @@ func TexParameteriv(target, pname Enum, params []int32) {
 	}
 }
 
+func TexStorage2D(target Enum, levels int, internalformat Enum, width, height int) {
+	_pluginInstance.glContext.Call("texStorage2D", int(target), levels, int(internalformat), width, height)
+}
+
+func TexStorage3D(target Enum, levels int, internalformat Enum, width, height, depth int) {
+	_pluginInstance.glContext.Call("texStorage3D", int(target), levels, int(internalformat), width, height, depth)
+}
+
+func TransformFeedbackVaryings(p Program, varyings []string, bufferMode Enum) {
+	jsVaryings := make([]interface{}, len(varyings))
+	for i, v := range varyings {
+		jsVaryings[i] = v
+	}
+	_pluginInstance.glContext.Call("transformFeedbackVaryings", programMap[p], jsVaryings, int(bufferMode))
+}
+
 //go:linkname memmove runtime.memmove
 func memmove(to, from unsafe.Pointer, n uintptr)
 
@@ -770,6 +1509,47 @@ func getInt32TypedArrayFromCacheUP(size int, src unsafe.Pointer) *js.TypedArray
 	return getInt32TypedArrayFromCache(b)
 }
 
+// uint32 array singleton, allocate 1KB at startup
+var uint32ArrayBuffer = make([]uint32, 0)
+var uint32ArrayBufferExtendFactor = 1
+
+const uint32Offset = unsafe.Sizeof(uint32(0))
+
+func getUint32ArrayBuffer(size int) []uint32 {
+	if size > len(uint32ArrayBuffer) {
+		for (1024 * uint32ArrayBufferExtendFactor) < size {
+			uint32ArrayBufferExtendFactor++
+		}
+		uint32ArrayBuffer = make([]uint32, (1024 * uint32ArrayBufferExtendFactor))
+	}
+	return uint32ArrayBuffer[:size]
+}
+
+var uint32TypedArrayCacheMap = make(map[uintptr]*js.TypedArray)
+
+func getUint32TypedArrayFromCache(src []uint32) *js.TypedArray {
+	key := uintptr(unsafe.Pointer(&src[0])) + uintptr(len(src))
+	if b, found := uint32TypedArrayCacheMap[key]; found {
+		return b
+	} else {
+		b := js.TypedArrayOf(src)
+		uint32TypedArrayCacheMap[key] = &b
+		return &b
+	}
+}
+
+func getUint32TypedArrayFromCacheP(size int, src *uint32) *js.TypedArray {
+	b := getUint32ArrayBuffer(size)
+	memmove(unsafe.Pointer(&b[0]), unsafe.Pointer(src), uintptr(size)*uint32Offset)
+	return getUint32TypedArrayFromCache(b)
+}
+
+func getUint32TypedArrayFromCacheUP(size int, src unsafe.Pointer) *js.TypedArray {
+	b := getUint32ArrayBuffer(size)
+	memmove(unsafe.Pointer(&b[0]), src, uintptr(size)*uint32Offset)
+	return getUint32TypedArrayFromCache(b)
+}
+
 // float32 array singleton, allocate 1KB at startup
 var float32ArrayBuffer = make([]float32, 0)
 var float32ArrayBufferExtendFactor = 1
@@ -844,6 +1624,22 @@ func Uniform1ivUP(dst Uniform, count int32, value unsafe.Pointer) {
 	_pluginInstance.glContext.Call("uniform1iv", uniformMap[dst], *getInt32TypedArrayFromCacheUP(int(count), value))
 }
 
+func Uniform1ui(dst Uniform, v uint32) {
+	_pluginInstance.glContext.Call("uniform1ui", uniformMap[dst], v)
+}
+
+func Uniform1uiv(dst Uniform, src []uint32) {
+	_pluginInstance.glContext.Call("uniform1uiv", uniformMap[dst], *getUint32TypedArrayFromCache(src))
+}
+
+func Uniform1uivP(dst Uniform, count int32, value *uint32) {
+	_pluginInstance.glContext.Call("uniform1uiv", uniformMap[dst], *getUint32TypedArrayFromCacheP(int(count), value))
+}
+
+func Uniform1uivUP(dst Uniform, count int32, value unsafe.Pointer) {
+	_pluginInstance.glContext.Call("uniform1uiv", uniformMap[dst], *getUint32TypedArrayFromCacheUP(int(count), value))
+}
+
 func Uniform2f(dst Uniform, v0, v1 float32) {
 	_pluginInstance.glContext.Call("uniform2f", uniformMap[dst], v0, v1)
 }
@@ -876,6 +1672,22 @@ func Uniform2ivUP(dst Uniform, count int32, value unsafe.Pointer) {
 	_pluginInstance.glContext.Call("uniform2iv", uniformMap[dst], *getInt32TypedArrayFromCacheUP(int(count*2), value))
 }
 
+func Uniform2ui(dst Uniform, v0, v1 uint32) {
+	_pluginInstance.glContext.Call("uniform2ui", uniformMap[dst], v0, v1)
+}
+
+func Uniform2uiv(dst Uniform, src []uint32) {
+	_pluginInstance.glContext.Call("uniform2uiv", uniformMap[dst], *getUint32TypedArrayFromCache(src))
+}
+
+func Uniform2uivP(dst Uniform, count int32, value *uint32) {
+	_pluginInstance.glContext.Call("uniform2uiv", uniformMap[dst], *getUint32TypedArrayFromCacheP(int(count*2), value))
+}
+
+func Uniform2uivUP(dst Uniform, count int32, value unsafe.Pointer) {
+	_pluginInstance.glContext.Call("uniform2uiv", uniformMap[dst], *getUint32TypedArrayFromCacheUP(int(count*2), value))
+}
+
 func Uniform3f(dst Uniform, v0, v1, v2 float32) {
 	_pluginInstance.glContext.Call("uniform3f", uniformMap[dst], v0, v1, v2)
 }
@@ -908,6 +1720,22 @@ func Uniform3ivUP(dst Uniform, count int32, value unsafe.Pointer) {
 	_pluginInstance.glContext.Call("uniform3iv", uniformMap[dst], *getInt32TypedArrayFromCacheUP(int(count*3), value))
 }
 
+func Uniform3ui(dst Uniform, v0, v1, v2 uint32) {
+	_pluginInstance.glContext.Call("uniform3ui", uniformMap[dst], v0, v1, v2)
+}
+
+func Uniform3uiv(dst Uniform, src []uint32) {
+	_pluginInstance.glContext.Call("uniform3uiv", uniformMap[dst], *getUint32TypedArrayFromCache(src))
+}
+
+func Uniform3uivP(dst Uniform, count int32, value *uint32) {
+	_pluginInstance.glContext.Call("uniform3uiv", uniformMap[dst], *getUint32TypedArrayFromCacheP(int(count*3), value))
+}
+
+func Uniform3uivUP(dst Uniform, count int32, value unsafe.Pointer) {
+	_pluginInstance.glContext.Call("uniform3uiv", uniformMap[dst], *getUint32TypedArrayFromCacheUP(int(count*3), value))
+}
+
 func Uniform4f(dst Uniform, v0, v1, v2, v3 float32) {
 	_pluginInstance.glContext.Call("uniform4f", uniformMap[dst], v0, v1, v2, v3)
 }
@@ -940,6 +1768,26 @@ func Uniform4ivUP(dst Uniform, count int32, value unsafe.Pointer) {
 	_pluginInstance.glContext.Call("uniform4iv", uniformMap[dst], *getInt32TypedArrayFromCacheUP(int(count*4), value))
 }
 
+func Uniform4ui(dst Uniform, v0, v1, v2, v3 uint32) {
+	_pluginInstance.glContext.Call("uniform4ui", uniformMap[dst], v0, v1, v2, v3)
+}
+
+func Uniform4uiv(dst Uniform, src []uint32) {
+	_pluginInstance.glContext.Call("uniform4uiv", uniformMap[dst], *getUint32TypedArrayFromCache(src))
+}
+
+func Uniform4uivP(dst Uniform, count int32, value *uint32) {
+	_pluginInstance.glContext.Call("uniform4uiv", uniformMap[dst], *getUint32TypedArrayFromCacheP(int(count*4), value))
+}
+
+func Uniform4uivUP(dst Uniform, count int32, value unsafe.Pointer) {
+	_pluginInstance.glContext.Call("uniform4uiv", uniformMap[dst], *getUint32TypedArrayFromCacheUP(int(count*4), value))
+}
+
+func UniformBlockBinding(p Program, index UniformBlock, binding uint32) {
+	_pluginInstance.glContext.Call("uniformBlockBinding", programMap[p], uint32(index), binding)
+}
+
 func UniformMatrix2fv(dst Uniform, transpose bool, src []float32) {
 	_pluginInstance.glContext.Call("uniformMatrix2fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCache(src))
 }
@@ -952,6 +1800,30 @@ func UniformMatrix2fvUP(dst Uniform, count int32, transpose bool, value unsafe.P
 	_pluginInstance.glContext.Call("uniformMatrix2fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCacheUP(int(count*4), value))
 }
 
+func UniformMatrix2x3fv(dst Uniform, transpose bool, src []float32) {
+	_pluginInstance.glContext.Call("uniformMatrix2x3fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCache(src))
+}
+
+func UniformMatrix2x3fvP(dst Uniform, count int32, transpose bool, value *float32) {
+	_pluginInstance.glContext.Call("uniformMatrix2x3fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCacheP(int(count*6), value))
+}
+
+func UniformMatrix2x3fvUP(dst Uniform, count int32, transpose bool, value unsafe.Pointer) {
+	_pluginInstance.glContext.Call("uniformMatrix2x3fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCacheUP(int(count*6), value))
+}
+
+func UniformMatrix2x4fv(dst Uniform, transpose bool, src []float32) {
+	_pluginInstance.glContext.Call("uniformMatrix2x4fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCache(src))
+}
+
+func UniformMatrix2x4fvP(dst Uniform, count int32, transpose bool, value *float32) {
+	_pluginInstance.glContext.Call("uniformMatrix2x4fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCacheP(int(count*8), value))
+}
+
+func UniformMatrix2x4fvUP(dst Uniform, count int32, transpose bool, value unsafe.Pointer) {
+	_pluginInstance.glContext.Call("uniformMatrix2x4fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCacheUP(int(count*8), value))
+}
+
 func UniformMatrix3fv(dst Uniform, transpose bool, src []float32) {
 	_pluginInstance.glContext.Call("uniformMatrix3fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCache(src))
 }
@@ -964,6 +1836,30 @@ func UniformMatrix3fvUP(dst Uniform, count int32, transpose bool, value unsafe.P
 	_pluginInstance.glContext.Call("uniformMatrix3fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCacheUP(int(count*9), value))
 }
 
+func UniformMatrix3x2fv(dst Uniform, transpose bool, src []float32) {
+	_pluginInstance.glContext.Call("uniformMatrix3x2fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCache(src))
+}
+
+func UniformMatrix3x2fvP(dst Uniform, count int32, transpose bool, value *float32) {
+	_pluginInstance.glContext.Call("uniformMatrix3x2fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCacheP(int(count*6), value))
+}
+
+func UniformMatrix3x2fvUP(dst Uniform, count int32, transpose bool, value unsafe.Pointer) {
+	_pluginInstance.glContext.Call("uniformMatrix3x2fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCacheUP(int(count*6), value))
+}
+
+func UniformMatrix3x4fv(dst Uniform, transpose bool, src []float32) {
+	_pluginInstance.glContext.Call("uniformMatrix3x4fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCache(src))
+}
+
+func UniformMatrix3x4fvP(dst Uniform, count int32, transpose bool, value *float32) {
+	_pluginInstance.glContext.Call("uniformMatrix3x4fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCacheP(int(count*12), value))
+}
+
+func UniformMatrix3x4fvUP(dst Uniform, count int32, transpose bool, value unsafe.Pointer) {
+	_pluginInstance.glContext.Call("uniformMatrix3x4fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCacheUP(int(count*12), value))
+}
+
 func UniformMatrix4fv(dst Uniform, transpose bool, src []float32) {
 	_pluginInstance.glContext.Call("uniformMatrix4fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCache(src))
 }
@@ -976,6 +1872,42 @@ func UniformMatrix4fvUP(dst Uniform, count int32, transpose bool, value unsafe.P
 	_pluginInstance.glContext.Call("uniformMatrix4fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCacheUP(int(count*16), value))
 }
 
+func UniformMatrix4x2fv(dst Uniform, transpose bool, src []float32) {
+	_pluginInstance.glContext.Call("uniformMatrix4x2fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCache(src))
+}
+
+func UniformMatrix4x2fvP(dst Uniform, count int32, transpose bool, value *float32) {
+	_pluginInstance.glContext.Call("uniformMatrix4x2fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCacheP(int(count*8), value))
+}
+
+func UniformMatrix4x2fvUP(dst Uniform, count int32, transpose bool, value unsafe.Pointer) {
+	_pluginInstance.glContext.Call("uniformMatrix4x2fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCacheUP(int(count*8), value))
+}
+
+func UniformMatrix4x3fv(dst Uniform, transpose bool, src []float32) {
+	_pluginInstance.glContext.Call("uniformMatrix4x3fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCache(src))
+}
+
+func UniformMatrix4x3fvP(dst Uniform, count int32, transpose bool, value *float32) {
+	_pluginInstance.glContext.Call("uniformMatrix4x3fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCacheP(int(count*12), value))
+}
+
+func UniformMatrix4x3fvUP(dst Uniform, count int32, transpose bool, value unsafe.Pointer) {
+	_pluginInstance.glContext.Call("uniformMatrix4x3fv", uniformMap[dst], transpose, *getFloat32TypedArrayFromCacheUP(int(count*12), value))
+}
+
+func UnmapBuffer(target Enum) bool {
+	mapped, found := mappedBufferMap[target]
+	if !found {
+		return false
+	}
+	if mapped.access&MAP_WRITE_BIT != 0 && mapped.access&MAP_FLUSH_EXPLICIT_BIT == 0 {
+		BufferSubData(target, mapped.offset, mapped.data)
+	}
+	delete(mappedBufferMap, target)
+	return true
+}
+
 func UseProgram(p Program) {
 	_pluginInstance.glContext.Call("useProgram", programMap[p])
 }
@@ -1016,10 +1948,31 @@ func VertexAttrib4fv(dst Attrib, src []float32) {
 	_pluginInstance.glContext.Call("vertexAttrib4fv", int32(dst), src)
 }
 
+func VertexAttribDivisor(index Attrib, divisor int) {
+	_pluginInstance.glContext.Call("vertexAttribDivisor", int32(index), divisor)
+}
+
+func VertexAttribI4i(dst Attrib, x, y, z, w int32) {
+	_pluginInstance.glContext.Call("vertexAttribI4i", int32(dst), x, y, z, w)
+}
+
+func VertexAttribI4ui(dst Attrib, x, y, z, w uint32) {
+	_pluginInstance.glContext.Call("vertexAttribI4ui", int32(dst), x, y, z, w)
+}
+
+func VertexAttribIPointer(dst Attrib, size int, ty Enum, stride, offset int) {
+	_pluginInstance.glContext.Call("vertexAttribIPointer", int32(dst), size, int(ty), stride, offset)
+}
+
 func VertexAttribPointer(dst Attrib, size int, ty Enum, normalized bool, stride, offset int) {
 	_pluginInstance.glContext.Call("vertexAttribPointer", int32(dst), size, int(ty), normalized, stride, offset)
 }
 
+// WaitSync only accepts TIMEOUT_IGNORED on WebGL2, which is -1 on JS side
+func WaitSync(s Sync, flags Enum, timeout uint64) {
+	_pluginInstance.glContext.Call("waitSync", syncMap[s], int(flags), -1)
+}
+
 func Viewport(x, y, width, height int) {
 	_pluginInstance.glContext.Call("viewport", x, y, width, height)
 }
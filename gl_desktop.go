@@ -4,12 +4,12 @@
 // +build !android
 // +build !ios
 // +build !js
+// +build !tgegldebug
 
 package gl
 
 import (
 	fmt "fmt"
-	strings "strings"
 	unsafe "unsafe"
 
 	gl "github.com/go-gl/gl/v3.3-core/gl"
@@ -38,6 +38,12 @@ func GetGLSLVersion() string {
 	return "330 core"
 }
 
+// programBinarySupported indicates if GetProgramBinary and ProgramBinary are available
+const programBinarySupported = true
+
+// uniformBlockSupported indicates if GetActiveUniformsiv can report uniforms blocks
+const uniformBlockSupported = true
+
 // FlushCache free memory cache, should be called between scenes
 func FlushCache() {
 	byteArrayBuffer = make([]byte, 0)
@@ -58,6 +64,20 @@ func AttachShader(p Program, s Shader) {
 	gl.AttachShader(uint32(p), uint32(s))
 }
 
+// BeginQuery delimits the start of a query object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glBeginQuery.xhtml
+func BeginQuery(target Enum, q Query) {
+	gl.BeginQuery(uint32(target), uint32(q))
+}
+
+// BeginTransformFeedback starts transform feedback operation.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glBeginTransformFeedback.xhtml
+func BeginTransformFeedback(primitiveMode Enum) {
+	gl.BeginTransformFeedback(uint32(primitiveMode))
+}
+
 // BindAttribLocation binds a vertex attribute index with a named
 // variable.
 //
@@ -73,6 +93,28 @@ func BindBuffer(target Enum, b Buffer) {
 	gl.BindBuffer(uint32(target), uint32(b))
 }
 
+// BindBufferBase binds a buffer to an indexed buffer target.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glBindBufferBase.xhtml
+func BindBufferBase(target Enum, index uint32, b Buffer) {
+	gl.BindBufferBase(uint32(target), index, uint32(b))
+}
+
+// BindBufferRange binds a range within a buffer to an indexed buffer target.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glBindBufferRange.xhtml
+func BindBufferRange(target Enum, index uint32, b Buffer, offset, size int) {
+	gl.BindBufferRange(uint32(target), index, uint32(b), offset, size)
+}
+
+// BindFragDataLocation binds a user-defined fragment shader output variable
+// to a color number, it must be called before linking the program.
+//
+// https://www.khronos.org/registry/OpenGL-Refpages/gl4/html/glBindFragDataLocation.xhtml
+func BindFragDataLocation(p Program, color uint32, name string) {
+	gl.BindFragDataLocation(uint32(p), color, gl.Str(name+"\x00"))
+}
+
 // BindFramebuffer binds a framebuffer.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glBindFramebuffer.xhtml
@@ -87,6 +129,14 @@ func BindRenderbuffer(target Enum, rb Renderbuffer) {
 	gl.BindRenderbuffer(uint32(target), uint32(rb))
 }
 
+// BindSampler binds a sampler object to the texture unit, it overrides the
+// sampling state of the texture bound to this unit.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glBindSampler.xhtml
+func BindSampler(unit uint32, s Sampler) {
+	gl.BindSampler(unit, uint32(s))
+}
+
 // BindTexture binds a texture.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glBindTexture.xhtml
@@ -94,6 +144,13 @@ func BindTexture(target Enum, t Texture) {
 	gl.BindTexture(uint32(target), uint32(t))
 }
 
+// BindTransformFeedback binds a transform feedback object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glBindTransformFeedback.xhtml
+func BindTransformFeedback(target Enum, tf TransformFeedback) {
+	gl.BindTransformFeedback(uint32(target), uint32(tf))
+}
+
 // BindVertexArray binds a VAO.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glBindVertexArray.xhtml
@@ -136,6 +193,14 @@ func BlendFuncSeparate(sfactorRGB, dfactorRGB, sfactorAlpha, dfactorAlpha Enum)
 	gl.BlendFuncSeparate(uint32(sfactorRGB), uint32(dfactorRGB), uint32(sfactorAlpha), uint32(dfactorAlpha))
 }
 
+// BlitFramebuffer copies a block of pixels from the read framebuffer to the
+// draw framebuffer, it is used to resolve multisampled framebuffers.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glBlitFramebuffer.xhtml
+func BlitFramebuffer(srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1 int, mask, filter Enum) {
+	gl.BlitFramebuffer(int32(srcX0), int32(srcY0), int32(srcX1), int32(srcY1), int32(dstX0), int32(dstY0), int32(dstX1), int32(dstY1), uint32(mask), uint32(filter))
+}
+
 // BufferData creates a new data store for the bound buffer object.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glBufferData.xhtml
@@ -175,6 +240,35 @@ func Clear(mask Enum) {
 	gl.Clear(uint32(mask))
 }
 
+// ClearBufferfi clears the depth and stencil buffers of the current draw
+// framebuffer at once, buffer must be DEPTH_STENCIL.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glClearBuffer.xhtml
+func ClearBufferfi(buffer Enum, drawbuffer int, depth float32, stencil int) {
+	gl.ClearBufferfi(uint32(buffer), int32(drawbuffer), depth, int32(stencil))
+}
+
+// ClearBufferfv clears a float buffer of the current draw framebuffer.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glClearBuffer.xhtml
+func ClearBufferfv(buffer Enum, drawbuffer int, value []float32) {
+	gl.ClearBufferfv(uint32(buffer), int32(drawbuffer), &value[0])
+}
+
+// ClearBufferiv clears a signed integer buffer of the current draw framebuffer.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glClearBuffer.xhtml
+func ClearBufferiv(buffer Enum, drawbuffer int, value []int32) {
+	gl.ClearBufferiv(uint32(buffer), int32(drawbuffer), &value[0])
+}
+
+// ClearBufferuiv clears an unsigned integer buffer of the current draw framebuffer.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glClearBuffer.xhtml
+func ClearBufferuiv(buffer Enum, drawbuffer int, value []uint32) {
+	gl.ClearBufferuiv(uint32(buffer), int32(drawbuffer), &value[0])
+}
+
 // ClearColor specifies the RGBA values used to clear color buffers.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glClearColor.xhtml
@@ -196,6 +290,14 @@ func ClearStencil(s int) {
 	gl.ClearStencil(int32(s))
 }
 
+// ClientWaitSync blocks until the sync object s is signaled or
+// timeout (in nanoseconds) expires, a zero timeout only polls s.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glClientWaitSync.xhtml
+func ClientWaitSync(s Sync, flags Enum, timeout uint64) Enum {
+	return Enum(gl.ClientWaitSync(uintptr(s), uint32(flags), timeout))
+}
+
 // ColorMask specifies whether color components in the framebuffer
 // can be written.
 //
@@ -218,6 +320,13 @@ func CompressedTexImage2D(target Enum, level int, internalformat Enum, width, he
 	gl.CompressedTexImage2D(uint32(target), int32(level), uint32(internalformat), int32(width), int32(height), int32(border), int32(len(data)), gl.Ptr(data))
 }
 
+// CompressedTexImage3D writes a compressed 3D texture or 2D texture array.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glCompressedTexImage3D.xhtml
+func CompressedTexImage3D(target Enum, level int, internalformat Enum, width, height, depth, border int, data []byte) {
+	gl.CompressedTexImage3D(uint32(target), int32(level), uint32(internalformat), int32(width), int32(height), int32(depth), int32(border), int32(len(data)), gl.Ptr(data))
+}
+
 // CompressedTexSubImage2D writes a subregion of a compressed 2D texture.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glCompressedTexSubImage2D.xhtml
@@ -225,6 +334,22 @@ func CompressedTexSubImage2D(target Enum, level, xoffset, yoffset, width, height
 	gl.CompressedTexSubImage2D(uint32(target), int32(level), int32(xoffset), int32(yoffset), int32(width), int32(height), uint32(format), int32(len(data)), gl.Ptr(data))
 }
 
+// CompressedTexSubImage3D writes a subregion of a compressed 3D texture
+// or 2D texture array.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glCompressedTexSubImage3D.xhtml
+func CompressedTexSubImage3D(target Enum, level, xoffset, yoffset, zoffset, width, height, depth int, format Enum, data []byte) {
+	gl.CompressedTexSubImage3D(uint32(target), int32(level), int32(xoffset), int32(yoffset), int32(zoffset), int32(width), int32(height), int32(depth), uint32(format), int32(len(data)), gl.Ptr(data))
+}
+
+// CopyBufferSubData copies part of the data store of the buffer bound to readTarget
+// into the buffer bound to writeTarget.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glCopyBufferSubData.xhtml
+func CopyBufferSubData(readTarget, writeTarget Enum, readOffset, writeOffset, size int) {
+	gl.CopyBufferSubData(uint32(readTarget), uint32(writeTarget), readOffset, writeOffset, size)
+}
+
 // CopyTexImage2D writes a 2D texture from the current framebuffer.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glCopyTexImage2D.xhtml
@@ -240,6 +365,14 @@ func CopyTexSubImage2D(target Enum, level, xoffset, yoffset, x, y, width, height
 	gl.CopyTexSubImage2D(uint32(target), int32(level), int32(xoffset), int32(yoffset), int32(x), int32(y), int32(width), int32(height))
 }
 
+// CopyTexSubImage3D writes a subregion of a 3D texture or 2D texture
+// array layer from the current framebuffer.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glCopyTexSubImage3D.xhtml
+func CopyTexSubImage3D(target Enum, level, xoffset, yoffset, zoffset, x, y, width, height int) {
+	gl.CopyTexSubImage3D(uint32(target), int32(level), int32(xoffset), int32(yoffset), int32(zoffset), int32(x), int32(y), int32(width), int32(height))
+}
+
 // CreateBuffer creates a buffer object.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGenBuffers.xhtml
@@ -265,6 +398,15 @@ func CreateProgram() Program {
 	return Program(uint32(gl.CreateProgram()))
 }
 
+// CreateQuery creates a query object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGenQueries.xhtml
+func CreateQuery() Query {
+	var q uint32
+	gl.GenQueries(1, &q)
+	return Query(q)
+}
+
 // CreateRenderbuffer create a renderbuffer object.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGenRenderbuffers.xhtml
@@ -274,6 +416,15 @@ func CreateRenderbuffer() Renderbuffer {
 	return Renderbuffer(b)
 }
 
+// CreateSampler creates a sampler object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGenSamplers.xhtml
+func CreateSampler() Sampler {
+	var s uint32
+	gl.GenSamplers(1, &s)
+	return Sampler(s)
+}
+
 // CreateShader creates a new empty shader object.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glCreateShader.xhtml
@@ -290,6 +441,15 @@ func CreateTexture() Texture {
 	return Texture(t)
 }
 
+// CreateTransformFeedback creates a transform feedback object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGenTransformFeedbacks.xhtml
+func CreateTransformFeedback() TransformFeedback {
+	var tf uint32
+	gl.GenTransformFeedbacks(1, &tf)
+	return TransformFeedback(tf)
+}
+
 // CreateVertexArray creates a VAO.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGenVertexArrays.xhtml
@@ -331,6 +491,14 @@ func DeleteProgram(p Program) {
 	gl.DeleteProgram(uint32(p))
 }
 
+// DeleteQuery deletes the given query object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glDeleteQueries.xhtml
+func DeleteQuery(v Query) {
+	u := uint32(v)
+	gl.DeleteQueries(1, &u)
+}
+
 // DeleteRenderbuffer deletes the given render buffer object.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glDeleteRenderbuffers.xhtml
@@ -339,6 +507,14 @@ func DeleteRenderbuffer(v Renderbuffer) {
 	gl.DeleteRenderbuffers(1, &u)
 }
 
+// DeleteSampler deletes the given sampler object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glDeleteSamplers.xhtml
+func DeleteSampler(v Sampler) {
+	u := uint32(v)
+	gl.DeleteSamplers(1, &u)
+}
+
 // DeleteShader deletes shader s.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glDeleteShader.xhtml
@@ -346,6 +522,13 @@ func DeleteShader(s Shader) {
 	gl.DeleteShader(uint32(s))
 }
 
+// DeleteSync deletes the given sync object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glDeleteSync.xhtml
+func DeleteSync(s Sync) {
+	gl.DeleteSync(uintptr(s))
+}
+
 // DeleteTexture deletes the given texture object.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glDeleteTextures.xhtml
@@ -354,6 +537,14 @@ func DeleteTexture(v Texture) {
 	gl.DeleteTextures(1, &u)
 }
 
+// DeleteTransformFeedback deletes the given transform feedback object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glDeleteTransformFeedbacks.xhtml
+func DeleteTransformFeedback(v TransformFeedback) {
+	u := uint32(v)
+	gl.DeleteTransformFeedbacks(1, &u)
+}
+
 // DeleteVertexArray deletes the given VAO.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glDeleteVertexArrays.xhtml
@@ -422,6 +613,20 @@ func DrawArrays(mode Enum, first, count int) {
 	gl.DrawArrays(uint32(mode), int32(first), int32(count))
 }
 
+// DrawArraysInstanced renders multiple instances of primitives from the bound data.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glDrawArraysInstanced.xhtml
+func DrawArraysInstanced(mode Enum, first, count, primcount int) {
+	gl.DrawArraysInstanced(uint32(mode), int32(first), int32(count), int32(primcount))
+}
+
+// DrawBuffers specifies the list of color buffers to be drawn into.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glDrawBuffers.xhtml
+func DrawBuffers(bufs []Enum) {
+	gl.DrawBuffers(int32(len(bufs)), (*uint32)(&bufs[0]))
+}
+
 // DrawElements renders primitives from a bound buffer.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glDrawElements.xhtml
@@ -429,6 +634,13 @@ func DrawElements(mode Enum, count int, ty Enum, offset int) {
 	gl.DrawElements(uint32(mode), int32(count), uint32(ty), gl.PtrOffset(offset))
 }
 
+// DrawElementsInstanced renders multiple instances of primitives from a bound buffer.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glDrawElementsInstanced.xhtml
+func DrawElementsInstanced(mode Enum, count int, ty Enum, offset, primcount int) {
+	gl.DrawElementsInstanced(uint32(mode), int32(count), uint32(ty), gl.PtrOffset(offset), int32(primcount))
+}
+
 // Enable enables various GL capabilities.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glEnable.xhtml
@@ -443,6 +655,27 @@ func EnableVertexAttribArray(a Attrib) {
 	gl.EnableVertexAttribArray(uint32(a))
 }
 
+// EndQuery delimits the end of a query object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glBeginQuery.xhtml
+func EndQuery(target Enum) {
+	gl.EndQuery(uint32(target))
+}
+
+// EndTransformFeedback ends transform feedback operation.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glBeginTransformFeedback.xhtml
+func EndTransformFeedback() {
+	gl.EndTransformFeedback()
+}
+
+// FenceSync creates a new sync object and inserts it into the GL command stream.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glFenceSync.xhtml
+func FenceSync(condition, flags Enum) Sync {
+	return Sync(gl.FenceSync(uint32(condition), uint32(flags)))
+}
+
 // Finish blocks until the effects of all previously called GL
 // commands are complete.
 //
@@ -461,6 +694,14 @@ func Flush() {
 	gl.Flush()
 }
 
+// FlushMappedBufferRange indicates modifications to a range of a mapped buffer,
+// offset is relative to the start of the mapped range.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glFlushMappedBufferRange.xhtml
+func FlushMappedBufferRange(target Enum, offset, length int) {
+	gl.FlushMappedBufferRange(uint32(target), offset, length)
+}
+
 // FramebufferRenderbuffer attaches rb to the current frame buffer.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glFramebufferRenderbuffer.xhtml
@@ -475,6 +716,14 @@ func FramebufferTexture2D(target, attachment, texTarget Enum, t Texture, level i
 	gl.FramebufferTexture2D(uint32(target), uint32(attachment), uint32(texTarget), uint32(t), int32(level))
 }
 
+// FramebufferTextureLayer attaches a single layer of the 3D texture or 2D texture
+// array t to the current frame buffer.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glFramebufferTextureLayer.xhtml
+func FramebufferTextureLayer(target, attachment Enum, t Texture, level, layer int) {
+	gl.FramebufferTextureLayer(uint32(target), uint32(attachment), uint32(t), int32(level), int32(layer))
+}
+
 // FrontFace defines which polygons are front-facing.
 //
 // Valid modes: CW, CCW.
@@ -498,13 +747,38 @@ func GenerateMipmap(target Enum) {
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGetActiveAttrib.xhtml
 func GetActiveAttrib(p Program, index uint32) (name string, size int, ty Enum) {
-	var length, si int32
+	var bufSize, length, si int32
 	var typ uint32
-	name = strings.Repeat("\x00", 256)
-	cname := gl.Str(name)
-	gl.GetActiveAttrib(uint32(p), uint32(index), int32(len(name)-1), &length, &si, &typ, cname)
-	name = name[:strings.IndexRune(name, 0)]
-	return name, int(si), Enum(typ)
+	gl.GetProgramiv(uint32(p), gl.ACTIVE_ATTRIBUTE_MAX_LENGTH, &bufSize)
+	if bufSize == 0 {
+		return "", 0, 0
+	}
+
+	nameBuffer := make([]uint8, bufSize)
+	gl.GetActiveAttrib(uint32(p), index, bufSize, &length, &si, &typ, &nameBuffer[0])
+	return string(nameBuffer[:length]), int(si), Enum(typ)
+}
+
+// GetActiveUniformBlockiv returns information about an active uniform block.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetActiveUniformBlockiv.xhtml
+func GetActiveUniformBlockiv(dst []int32, p Program, index UniformBlock, pname Enum) {
+	gl.GetActiveUniformBlockiv(uint32(p), uint32(index), uint32(pname), &dst[0])
+}
+
+// GetActiveUniformBlockName returns the name of an active uniform block.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetActiveUniformBlockName.xhtml
+func GetActiveUniformBlockName(p Program, index UniformBlock) string {
+	var nameLength int32
+	gl.GetActiveUniformBlockiv(uint32(p), uint32(index), gl.UNIFORM_BLOCK_NAME_LENGTH, &nameLength)
+	if nameLength == 0 {
+		return ""
+	}
+
+	nameBuffer := make([]uint8, nameLength)
+	gl.GetActiveUniformBlockName(uint32(p), uint32(index), nameLength, nil, &nameBuffer[0])
+	return gl.GoStr(&nameBuffer[0])
 }
 
 // GetActiveUniform returns details about an active uniform variable.
@@ -514,13 +788,24 @@ func GetActiveAttrib(p Program, index uint32) (name string, size int, ty Enum) {
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGetActiveUniform.xhtml
 func GetActiveUniform(p Program, index uint32) (name string, size int, ty Enum) {
-	var length, si int32
+	var bufSize, length, si int32
 	var typ uint32
-	name = strings.Repeat("\x00", 256)
-	cname := gl.Str(name)
-	gl.GetActiveUniform(uint32(p), uint32(index), int32(len(name)-1), &length, &si, &typ, cname)
-	name = name[:strings.IndexRune(name, 0)]
-	return name, int(si), Enum(typ)
+	gl.GetProgramiv(uint32(p), gl.ACTIVE_UNIFORM_MAX_LENGTH, &bufSize)
+	if bufSize == 0 {
+		return "", 0, 0
+	}
+
+	nameBuffer := make([]uint8, bufSize)
+	gl.GetActiveUniform(uint32(p), index, bufSize, &length, &si, &typ, &nameBuffer[0])
+	return string(nameBuffer[:length]), int(si), Enum(typ)
+}
+
+// GetActiveUniformsiv returns a parameter of several active uniforms at once,
+// dst must be at least len(indices) long.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetActiveUniformsiv.xhtml
+func GetActiveUniformsiv(dst []int32, p Program, indices []uint32, pname Enum) {
+	gl.GetActiveUniformsiv(uint32(p), int32(len(indices)), &indices[0], uint32(pname), &dst[0])
 }
 
 // GetAttachedShaders returns the shader objects attached to program p.
@@ -589,6 +874,13 @@ func GetBufferParameteri(target, pname Enum) int {
 	return int(params)
 }
 
+// GetBufferSubData reads len(dst) bytes of the bound buffer object starting at offset.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetBufferSubData.xhtml
+func GetBufferSubData(target Enum, offset int, dst []byte) {
+	gl.GetBufferSubData(uint32(target), offset, len(dst), gl.Ptr(&dst[0]))
+}
+
 // GetError returns the next error.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGetError.xhtml
@@ -605,6 +897,14 @@ func GetBoundFramebuffer() Framebuffer {
 	return Framebuffer(uint32(b))
 }
 
+// GetFragDataLocation returns the color number bound to a fragment shader output
+// variable, -1 if name is not an output of the program.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetFragDataLocation.xhtml
+func GetFragDataLocation(p Program, name string) int {
+	return int(gl.GetFragDataLocation(uint32(p), gl.Str(name+"\x00")))
+}
+
 // GetFramebufferAttachmentParameteri returns attachment parameters
 // for the active framebuffer object.
 //
@@ -615,6 +915,22 @@ func GetFramebufferAttachmentParameteri(target, attachment, pname Enum) int {
 	return int(param)
 }
 
+// GetProgramBinary returns the binary representation of a linked program,
+// data is empty if the driver does not provide it.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetProgramBinary.xhtml
+func GetProgramBinary(p Program) (data []byte, format Enum) {
+	length := int32(GetProgrami(p, PROGRAM_BINARY_LENGTH))
+	if length == 0 {
+		return nil, NONE
+	}
+
+	data = make([]byte, length)
+	var binaryFormat uint32
+	gl.GetProgramBinary(uint32(p), length, &length, &binaryFormat, gl.Ptr(&data[0]))
+	return data[:length], Enum(binaryFormat)
+}
+
 // GetProgrami returns a parameter value for a program.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGetProgramiv.xhtml
@@ -639,6 +955,27 @@ func GetProgramInfoLog(p Program) string {
 	return gl.GoStr(&logBuffer[0])
 }
 
+// GetQueryi returns a parameter value for a query target.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetQueryiv.xhtml
+func GetQueryi(target, pname Enum) int {
+	var params int32
+	gl.GetQueryiv(uint32(target), uint32(pname), &params)
+	return int(params)
+}
+
+// GetQueryObjectui returns a parameter value for a query object.
+//
+// Querying QUERY_RESULT waits for the result, use PollQueryResult
+// to avoid stalling.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetQueryObjectuiv.xhtml
+func GetQueryObjectui(q Query, pname Enum) uint32 {
+	var params uint32
+	gl.GetQueryObjectuiv(uint32(q), uint32(pname), &params)
+	return params
+}
+
 // GetRenderbufferParameteri returns a parameter value for a render buffer.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGetRenderbufferParameteriv.xhtml
@@ -648,6 +985,20 @@ func GetRenderbufferParameteri(target, pname Enum) int {
 	return int(result)
 }
 
+// GetSamplerParameterfv returns the float values of a sampler parameter.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetSamplerParameter.xhtml
+func GetSamplerParameterfv(dst []float32, s Sampler, pname Enum) {
+	gl.GetSamplerParameterfv(uint32(s), uint32(pname), &dst[0])
+}
+
+// GetSamplerParameteriv returns the int values of a sampler parameter.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetSamplerParameter.xhtml
+func GetSamplerParameteriv(dst []int32, s Sampler, pname Enum) {
+	gl.GetSamplerParameteriv(uint32(s), uint32(pname), &dst[0])
+}
+
 // GetShaderi returns a parameter value for a shader.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGetShaderiv.xhtml
@@ -711,6 +1062,13 @@ func GetString(pname Enum) string {
 	return gl.GoStr(gl.GetString(uint32(pname)))
 }
 
+// GetSynciv returns the properties of a sync object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetSynciv.xhtml
+func GetSynciv(dst []int32, s Sync, pname Enum) {
+	gl.GetSynciv(uintptr(s), uint32(pname), int32(len(dst)), nil, &dst[0])
+}
+
 // GetTexParameterfv returns the float values of a texture parameter.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGetTexParameter.xhtml
@@ -725,6 +1083,22 @@ func GetTexParameteriv(dst []int32, target, pname Enum) {
 	gl.GetTexParameteriv(uint32(target), uint32(pname), &dst[0])
 }
 
+// GetTransformFeedbackVarying returns details about a varying variable
+// selected for transform feedback.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetTransformFeedbackVarying.xhtml
+func GetTransformFeedbackVarying(p Program, index uint32) (name string, size int, ty Enum) {
+	var length, si int32
+	var typ uint32
+	maxLength := GetProgrami(p, TRANSFORM_FEEDBACK_VARYING_MAX_LENGTH)
+	if maxLength == 0 {
+		return "", 0, NONE
+	}
+	nameBuffer := make([]uint8, maxLength)
+	gl.GetTransformFeedbackVarying(uint32(p), index, int32(maxLength), &length, &si, &typ, &nameBuffer[0])
+	return gl.GoStr(&nameBuffer[0]), int(si), Enum(typ)
+}
+
 // GetUniformfv returns the float values of a uniform variable.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGetUniform.xhtml
@@ -739,6 +1113,32 @@ func GetUniformiv(dst []int32, src Uniform, p Program) {
 	gl.GetUniformiv(uint32(p), int32(src), &dst[0])
 }
 
+// GetUniformBlockIndex returns the index of a named uniform block.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetUniformBlockIndex.xhtml
+func GetUniformBlockIndex(p Program, name string) UniformBlock {
+	return UniformBlock(gl.GetUniformBlockIndex(uint32(p), gl.Str(name+"\x00")))
+}
+
+// GetUniformIndices returns the indices of the given uniforms, INVALID_INDEX is set
+// for names which are not active uniforms of the program.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetUniformIndices.xhtml
+func GetUniformIndices(p Program, names []string) []uint32 {
+	indices := make([]uint32, len(names))
+	if len(names) == 0 {
+		return indices
+	}
+	cnames := make([]string, len(names))
+	for i, n := range names {
+		cnames[i] = n + "\x00"
+	}
+	glnames, free := gl.Strs(cnames...)
+	gl.GetUniformIndices(uint32(p), int32(len(names)), glnames, &indices[0])
+	free()
+	return indices
+}
+
 // GetUniformLocation returns the location of a uniform variable.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGetUniformLocation.xhtml
@@ -778,6 +1178,13 @@ func GetVertexAttribiv(dst []int32, src Attrib, pname Enum) {
 	gl.GetVertexAttribiv(uint32(src), uint32(pname), &dst[0])
 }
 
+// GetVertexAttribIiv reads int values of an integer vertex attribute.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetVertexAttrib.xhtml
+func GetVertexAttribIiv(dst []int32, src Attrib, pname Enum) {
+	gl.GetVertexAttribIiv(uint32(src), uint32(pname), &dst[0])
+}
+
 // Hint sets implementation-specific modes.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glHint.xhtml
@@ -785,6 +1192,22 @@ func Hint(target, mode Enum) {
 	gl.Hint(uint32(target), uint32(mode))
 }
 
+// InvalidateFramebuffer indicates that the content of the given attachments
+// of the bound framebuffer is no longer needed.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glInvalidateFramebuffer.xhtml
+func InvalidateFramebuffer(target Enum, attachments []Enum) {
+	gl.InvalidateFramebuffer(uint32(target), int32(len(attachments)), (*uint32)(&attachments[0]))
+}
+
+// InvalidateSubFramebuffer indicates that the content of a region of the given
+// attachments of the bound framebuffer is no longer needed.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glInvalidateSubFramebuffer.xhtml
+func InvalidateSubFramebuffer(target Enum, attachments []Enum, x, y, width, height int) {
+	gl.InvalidateSubFramebuffer(uint32(target), int32(len(attachments)), (*uint32)(&attachments[0]), int32(x), int32(y), int32(width), int32(height))
+}
+
 // IsBuffer reports if b is a valid buffer.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glIsBuffer.xhtml
@@ -848,6 +1271,25 @@ func LinkProgram(p Program) {
 	gl.LinkProgram(uint32(p))
 }
 
+// MapBufferRange maps a range of the bound buffer object into client memory,
+// the returned slice is only valid until UnmapBuffer is called.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glMapBufferRange.xhtml
+func MapBufferRange(target Enum, offset, length int, access Enum) []byte {
+	p := gl.MapBufferRange(uint32(target), offset, length, uint32(access))
+	if p == nil {
+		return nil
+	}
+	return (*[1 << 30]byte)(p)[:length:length]
+}
+
+// PauseTransformFeedback pauses transform feedback operations.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glPauseTransformFeedback.xhtml
+func PauseTransformFeedback() {
+	gl.PauseTransformFeedback()
+}
+
 // PixelStorei sets pixel storage parameters.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glPixelStorei.xhtml
@@ -869,6 +1311,29 @@ func PolygonOffset(factor, units float32) {
 	gl.PolygonOffset(factor, units)
 }
 
+// ProgramBinary loads a program from a binary previously returned by GetProgramBinary,
+// LINK_STATUS must be checked as drivers may reject outdated binaries.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glProgramBinary.xhtml
+func ProgramBinary(p Program, format Enum, data []byte) {
+	gl.ProgramBinary(uint32(p), uint32(format), gl.Ptr(&data[0]), int32(len(data)))
+}
+
+// ProgramParameteri sets a parameter of program p, PROGRAM_BINARY_RETRIEVABLE_HINT
+// must be set to TRUE before linking to retrieve the program binary.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glProgramParameteri.xhtml
+func ProgramParameteri(p Program, pname Enum, value int) {
+	gl.ProgramParameteri(uint32(p), uint32(pname), int32(value))
+}
+
+// ReadBuffer selects the color buffer source for pixels read operations.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glReadBuffer.xhtml
+func ReadBuffer(src Enum) {
+	gl.ReadBuffer(uint32(src))
+}
+
 // ReadPixels returns pixel data from a buffer.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glReadPixels.xhtml
@@ -891,6 +1356,21 @@ func RenderbufferStorage(target, internalFormat Enum, width, height int) {
 	gl.RenderbufferStorage(uint32(target), uint32(internalFormat), int32(width), int32(height))
 }
 
+// RenderbufferStorageMultisample establishes a multisampled data storage, format,
+// and dimensions of a renderbuffer object's image.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glRenderbufferStorageMultisample.xhtml
+func RenderbufferStorageMultisample(target Enum, samples int, internalFormat Enum, width, height int) {
+	gl.RenderbufferStorageMultisample(uint32(target), int32(samples), uint32(internalFormat), int32(width), int32(height))
+}
+
+// ResumeTransformFeedback resumes transform feedback operations.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glResumeTransformFeedback.xhtml
+func ResumeTransformFeedback() {
+	gl.ResumeTransformFeedback()
+}
+
 // SampleCoverage sets multisample coverage parameters.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glSampleCoverage.xhtml
@@ -898,6 +1378,34 @@ func SampleCoverage(value float32, invert bool) {
 	gl.SampleCoverage(value, invert)
 }
 
+// SamplerParameterf sets a float sampler parameter.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glSamplerParameter.xhtml
+func SamplerParameterf(s Sampler, pname Enum, param float32) {
+	gl.SamplerParameterf(uint32(s), uint32(pname), param)
+}
+
+// SamplerParameterfv sets a float sampler parameter array.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glSamplerParameter.xhtml
+func SamplerParameterfv(s Sampler, pname Enum, params []float32) {
+	gl.SamplerParameterfv(uint32(s), uint32(pname), &params[0])
+}
+
+// SamplerParameteri sets an integer sampler parameter.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glSamplerParameter.xhtml
+func SamplerParameteri(s Sampler, pname Enum, param int) {
+	gl.SamplerParameteri(uint32(s), uint32(pname), int32(param))
+}
+
+// SamplerParameteriv sets an integer sampler parameter array.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glSamplerParameter.xhtml
+func SamplerParameteriv(s Sampler, pname Enum, params []int32) {
+	gl.SamplerParameteriv(uint32(s), uint32(pname), &params[0])
+}
+
 // Scissor defines the scissor box rectangle, in window coordinates.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glScissor.xhtml
@@ -967,6 +1475,29 @@ func TexImage2D(target Enum, level int, width, height int, format Enum, ty Enum,
 	gl.TexImage2D(uint32(target), int32(level), int32(format), int32(width), int32(height), 0, uint32(format), uint32(ty), p)
 }
 
+// TexImage2DInternal writes a 2D texture image using a distinct internal format,
+// it allows sized internal formats like RGBA16F, R32F or DEPTH_COMPONENT24.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glTexImage2D.xhtml
+func TexImage2DInternal(target Enum, level int, internalFormat Enum, width, height int, format Enum, ty Enum, data []byte) {
+	p := unsafe.Pointer(nil)
+	if len(data) > 0 {
+		p = gl.Ptr(&data[0])
+	}
+	gl.TexImage2D(uint32(target), int32(level), int32(internalFormat), int32(width), int32(height), 0, uint32(format), uint32(ty), p)
+}
+
+// TexImage3D writes a 3D texture or 2D texture array image.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glTexImage3D.xhtml
+func TexImage3D(target Enum, level int, width, height, depth int, format Enum, ty Enum, data []byte) {
+	p := unsafe.Pointer(nil)
+	if len(data) > 0 {
+		p = gl.Ptr(&data[0])
+	}
+	gl.TexImage3D(uint32(target), int32(level), int32(format), int32(width), int32(height), int32(depth), 0, uint32(format), uint32(ty), p)
+}
+
 // TexSubImage2D writes a subregion of a 2D texture image.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glTexSubImage2D.xhtml
@@ -974,6 +1505,13 @@ func TexSubImage2D(target Enum, level int, x, y, width, height int, format, ty E
 	gl.TexSubImage2D(uint32(target), int32(level), int32(x), int32(y), int32(width), int32(height), uint32(format), uint32(ty), gl.Ptr(&data[0]))
 }
 
+// TexSubImage3D writes a subregion of a 3D texture or 2D texture array image.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glTexSubImage3D.xhtml
+func TexSubImage3D(target Enum, level int, x, y, z, width, height, depth int, format, ty Enum, data []byte) {
+	gl.TexSubImage3D(uint32(target), int32(level), int32(x), int32(y), int32(z), int32(width), int32(height), int32(depth), uint32(format), uint32(ty), gl.Ptr(&data[0]))
+}
+
 // TexParameterf sets a float texture parameter.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glTexParameter.xhtml
@@ -1002,6 +1540,39 @@ func TexParameteriv(target, pname Enum, params []int32) {
 	gl.TexParameteriv(uint32(target), uint32(pname), &params[0])
 }
 
+// TexStorage2D allocates an immutable storage for all levels of a 2D texture.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glTexStorage2D.xhtml
+func TexStorage2D(target Enum, levels int, internalformat Enum, width, height int) {
+	gl.TexStorage2D(uint32(target), int32(levels), uint32(internalformat), int32(width), int32(height))
+}
+
+// TexStorage3D allocates an immutable storage for all levels of a 3D texture
+// or 2D texture array.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glTexStorage3D.xhtml
+func TexStorage3D(target Enum, levels int, internalformat Enum, width, height, depth int) {
+	gl.TexStorage3D(uint32(target), int32(levels), uint32(internalformat), int32(width), int32(height), int32(depth))
+}
+
+// TransformFeedbackVaryings specifies values to record in transform
+// feedback buffers.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glTransformFeedbackVaryings.xhtml
+func TransformFeedbackVaryings(p Program, varyings []string, bufferMode Enum) {
+	if len(varyings) == 0 {
+		gl.TransformFeedbackVaryings(uint32(p), 0, nil, uint32(bufferMode))
+		return
+	}
+	cvaryings := make([]string, len(varyings))
+	for i, v := range varyings {
+		cvaryings[i] = v + "\x00"
+	}
+	glvaryings, free := gl.Strs(cvaryings...)
+	gl.TransformFeedbackVaryings(uint32(p), int32(len(varyings)), glvaryings, uint32(bufferMode))
+	free()
+}
+
 // Uniform1f writes a float uniform variable.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
@@ -1074,6 +1645,38 @@ func Uniform1ivUP(dst Uniform, count int32, value unsafe.Pointer) {
 	}
 }
 
+// Uniform1ui writes a uint uniform variable.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func Uniform1ui(dst Uniform, v uint32) {
+	if dst.Valid() {
+		gl.Uniform1ui(int32(dst), v)
+	}
+}
+
+// Uniform1uiv writes a uint uniform array of len(src) elements.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func Uniform1uiv(dst Uniform, src []uint32) {
+	if dst.Valid() {
+		gl.Uniform1uiv(int32(dst), int32(len(src)), &src[0])
+	}
+}
+
+// Uniform1uivP Pointer version of Uniform1uiv (faster)
+func Uniform1uivP(dst Uniform, count int32, value *uint32) {
+	if dst.Valid() {
+		gl.Uniform1uiv(int32(dst), count, value)
+	}
+}
+
+// Uniform1uivUP Unsafe Pointer version of Uniform1uiv (faster)
+func Uniform1uivUP(dst Uniform, count int32, value unsafe.Pointer) {
+	if dst.Valid() {
+		gl.Uniform1uiv(int32(dst), count, (*uint32)(value))
+	}
+}
+
 // Uniform2f writes a vec2 uniform variable.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
@@ -1138,6 +1741,38 @@ func Uniform2ivUP(dst Uniform, count int32, value unsafe.Pointer) {
 	}
 }
 
+// Uniform2ui writes a uvec2 uniform variable.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func Uniform2ui(dst Uniform, v0, v1 uint32) {
+	if dst.Valid() {
+		gl.Uniform2ui(int32(dst), v0, v1)
+	}
+}
+
+// Uniform2uiv writes a uvec2 uniform array of len(src)/2 elements.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func Uniform2uiv(dst Uniform, src []uint32) {
+	if dst.Valid() {
+		gl.Uniform2uiv(int32(dst), int32(len(src)/2), &src[0])
+	}
+}
+
+// Uniform2uivP Pointer version of Uniform2uiv (faster)
+func Uniform2uivP(dst Uniform, count int32, value *uint32) {
+	if dst.Valid() {
+		gl.Uniform2uiv(int32(dst), count, value)
+	}
+}
+
+// Uniform2uivUP Unsafe Pointer version of Uniform2uiv (faster)
+func Uniform2uivUP(dst Uniform, count int32, value unsafe.Pointer) {
+	if dst.Valid() {
+		gl.Uniform2uiv(int32(dst), count, (*uint32)(value))
+	}
+}
+
 // Uniform3f writes a vec3 uniform variable.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
@@ -1202,6 +1837,38 @@ func Uniform3ivUP(dst Uniform, count int32, value unsafe.Pointer) {
 	}
 }
 
+// Uniform3ui writes a uvec3 uniform variable.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func Uniform3ui(dst Uniform, v0, v1, v2 uint32) {
+	if dst.Valid() {
+		gl.Uniform3ui(int32(dst), v0, v1, v2)
+	}
+}
+
+// Uniform3uiv writes a uvec3 uniform array of len(src)/3 elements.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func Uniform3uiv(dst Uniform, src []uint32) {
+	if dst.Valid() {
+		gl.Uniform3uiv(int32(dst), int32(len(src)/3), &src[0])
+	}
+}
+
+// Uniform3uivP Pointer version of Uniform3uiv (faster)
+func Uniform3uivP(dst Uniform, count int32, value *uint32) {
+	if dst.Valid() {
+		gl.Uniform3uiv(int32(dst), count, value)
+	}
+}
+
+// Uniform3uivUP Unsafe Pointer version of Uniform3uiv (faster)
+func Uniform3uivUP(dst Uniform, count int32, value unsafe.Pointer) {
+	if dst.Valid() {
+		gl.Uniform3uiv(int32(dst), count, (*uint32)(value))
+	}
+}
+
 // Uniform4f writes a vec4 uniform variable.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
@@ -1266,6 +1933,45 @@ func Uniform4ivUP(dst Uniform, count int32, value unsafe.Pointer) {
 	}
 }
 
+// Uniform4ui writes a uvec4 uniform variable.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func Uniform4ui(dst Uniform, v0, v1, v2, v3 uint32) {
+	if dst.Valid() {
+		gl.Uniform4ui(int32(dst), v0, v1, v2, v3)
+	}
+}
+
+// Uniform4uiv writes a uvec4 uniform array of len(src)/4 elements.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func Uniform4uiv(dst Uniform, src []uint32) {
+	if dst.Valid() {
+		gl.Uniform4uiv(int32(dst), int32(len(src)/4), &src[0])
+	}
+}
+
+// Uniform4uivP Pointer version of Uniform4uiv (faster)
+func Uniform4uivP(dst Uniform, count int32, value *uint32) {
+	if dst.Valid() {
+		gl.Uniform4uiv(int32(dst), count, value)
+	}
+}
+
+// Uniform4uivUP Unsafe Pointer version of Uniform4uiv (faster)
+func Uniform4uivUP(dst Uniform, count int32, value unsafe.Pointer) {
+	if dst.Valid() {
+		gl.Uniform4uiv(int32(dst), count, (*uint32)(value))
+	}
+}
+
+// UniformBlockBinding assigns a binding point to an active uniform block.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniformBlockBinding.xhtml
+func UniformBlockBinding(p Program, index UniformBlock, binding uint32) {
+	gl.UniformBlockBinding(uint32(p), uint32(index), binding)
+}
+
 // UniformMatrix2fv writes 2x2 matrices. Each matrix uses four
 // float32 values, so the number of matrices written is len(src)/4.
 //
@@ -1292,6 +1998,58 @@ func UniformMatrix2fvUP(dst Uniform, count int32, transpose bool, value unsafe.P
 	}
 }
 
+// UniformMatrix2x3fv writes 2x3 matrices. Each matrix uses six
+// float32 values, so the number of matrices written is len(src)/6.
+//
+// Each matrix must be supplied in column major order.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func UniformMatrix2x3fv(dst Uniform, transpose bool, src []float32) {
+	if dst.Valid() {
+		gl.UniformMatrix2x3fv(int32(dst), int32(len(src)/(2*3)), transpose, &src[0])
+	}
+}
+
+// UniformMatrix2x3fvP Pointer version of UniformMatrix2x3fv (faster)
+func UniformMatrix2x3fvP(dst Uniform, count int32, transpose bool, value *float32) {
+	if dst.Valid() {
+		gl.UniformMatrix2x3fv(int32(dst), count, transpose, value)
+	}
+}
+
+// UniformMatrix2x3fvUP Unsafe Pointer version of UniformMatrix2x3fv (faster)
+func UniformMatrix2x3fvUP(dst Uniform, count int32, transpose bool, value unsafe.Pointer) {
+	if dst.Valid() {
+		gl.UniformMatrix2x3fv(int32(dst), count, transpose, (*float32)(value))
+	}
+}
+
+// UniformMatrix2x4fv writes 2x4 matrices. Each matrix uses eight
+// float32 values, so the number of matrices written is len(src)/8.
+//
+// Each matrix must be supplied in column major order.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func UniformMatrix2x4fv(dst Uniform, transpose bool, src []float32) {
+	if dst.Valid() {
+		gl.UniformMatrix2x4fv(int32(dst), int32(len(src)/(2*4)), transpose, &src[0])
+	}
+}
+
+// UniformMatrix2x4fvP Pointer version of UniformMatrix2x4fv (faster)
+func UniformMatrix2x4fvP(dst Uniform, count int32, transpose bool, value *float32) {
+	if dst.Valid() {
+		gl.UniformMatrix2x4fv(int32(dst), count, transpose, value)
+	}
+}
+
+// UniformMatrix2x4fvUP Unsafe Pointer version of UniformMatrix2x4fv (faster)
+func UniformMatrix2x4fvUP(dst Uniform, count int32, transpose bool, value unsafe.Pointer) {
+	if dst.Valid() {
+		gl.UniformMatrix2x4fv(int32(dst), count, transpose, (*float32)(value))
+	}
+}
+
 // UniformMatrix3fv writes 3x3 matrices. Each matrix uses nine
 // float32 values, so the number of matrices written is len(src)/9.
 //
@@ -1318,6 +2076,58 @@ func UniformMatrix3fvUP(dst Uniform, count int32, transpose bool, value unsafe.P
 	}
 }
 
+// UniformMatrix3x2fv writes 3x2 matrices. Each matrix uses six
+// float32 values, so the number of matrices written is len(src)/6.
+//
+// Each matrix must be supplied in column major order.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func UniformMatrix3x2fv(dst Uniform, transpose bool, src []float32) {
+	if dst.Valid() {
+		gl.UniformMatrix3x2fv(int32(dst), int32(len(src)/(3*2)), transpose, &src[0])
+	}
+}
+
+// UniformMatrix3x2fvP Pointer version of UniformMatrix3x2fv (faster)
+func UniformMatrix3x2fvP(dst Uniform, count int32, transpose bool, value *float32) {
+	if dst.Valid() {
+		gl.UniformMatrix3x2fv(int32(dst), count, transpose, value)
+	}
+}
+
+// UniformMatrix3x2fvUP Unsafe Pointer version of UniformMatrix3x2fv (faster)
+func UniformMatrix3x2fvUP(dst Uniform, count int32, transpose bool, value unsafe.Pointer) {
+	if dst.Valid() {
+		gl.UniformMatrix3x2fv(int32(dst), count, transpose, (*float32)(value))
+	}
+}
+
+// UniformMatrix3x4fv writes 3x4 matrices. Each matrix uses 12
+// float32 values, so the number of matrices written is len(src)/12.
+//
+// Each matrix must be supplied in column major order.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func UniformMatrix3x4fv(dst Uniform, transpose bool, src []float32) {
+	if dst.Valid() {
+		gl.UniformMatrix3x4fv(int32(dst), int32(len(src)/(3*4)), transpose, &src[0])
+	}
+}
+
+// UniformMatrix3x4fvP Pointer version of UniformMatrix3x4fv (faster)
+func UniformMatrix3x4fvP(dst Uniform, count int32, transpose bool, value *float32) {
+	if dst.Valid() {
+		gl.UniformMatrix3x4fv(int32(dst), count, transpose, value)
+	}
+}
+
+// UniformMatrix3x4fvUP Unsafe Pointer version of UniformMatrix3x4fv (faster)
+func UniformMatrix3x4fvUP(dst Uniform, count int32, transpose bool, value unsafe.Pointer) {
+	if dst.Valid() {
+		gl.UniformMatrix3x4fv(int32(dst), count, transpose, (*float32)(value))
+	}
+}
+
 // UniformMatrix4fv writes 4x4 matrices. Each matrix uses 16
 // float32 values, so the number of matrices written is len(src)/16.
 //
@@ -1344,6 +2154,66 @@ func UniformMatrix4fvUP(dst Uniform, count int32, transpose bool, value unsafe.P
 	}
 }
 
+// UniformMatrix4x2fv writes 4x2 matrices. Each matrix uses eight
+// float32 values, so the number of matrices written is len(src)/8.
+//
+// Each matrix must be supplied in column major order.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func UniformMatrix4x2fv(dst Uniform, transpose bool, src []float32) {
+	if dst.Valid() {
+		gl.UniformMatrix4x2fv(int32(dst), int32(len(src)/(4*2)), transpose, &src[0])
+	}
+}
+
+// UniformMatrix4x2fvP Pointer version of UniformMatrix4x2fv (faster)
+func UniformMatrix4x2fvP(dst Uniform, count int32, transpose bool, value *float32) {
+	if dst.Valid() {
+		gl.UniformMatrix4x2fv(int32(dst), count, transpose, value)
+	}
+}
+
+// UniformMatrix4x2fvUP Unsafe Pointer version of UniformMatrix4x2fv (faster)
+func UniformMatrix4x2fvUP(dst Uniform, count int32, transpose bool, value unsafe.Pointer) {
+	if dst.Valid() {
+		gl.UniformMatrix4x2fv(int32(dst), count, transpose, (*float32)(value))
+	}
+}
+
+// UniformMatrix4x3fv writes 4x3 matrices. Each matrix uses 12
+// float32 values, so the number of matrices written is len(src)/12.
+//
+// Each matrix must be supplied in column major order.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func UniformMatrix4x3fv(dst Uniform, transpose bool, src []float32) {
+	if dst.Valid() {
+		gl.UniformMatrix4x3fv(int32(dst), int32(len(src)/(4*3)), transpose, &src[0])
+	}
+}
+
+// UniformMatrix4x3fvP Pointer version of UniformMatrix4x3fv (faster)
+func UniformMatrix4x3fvP(dst Uniform, count int32, transpose bool, value *float32) {
+	if dst.Valid() {
+		gl.UniformMatrix4x3fv(int32(dst), count, transpose, value)
+	}
+}
+
+// UniformMatrix4x3fvUP Unsafe Pointer version of UniformMatrix4x3fv (faster)
+func UniformMatrix4x3fvUP(dst Uniform, count int32, transpose bool, value unsafe.Pointer) {
+	if dst.Valid() {
+		gl.UniformMatrix4x3fv(int32(dst), count, transpose, (*float32)(value))
+	}
+}
+
+// UnmapBuffer releases the mapping of the bound buffer object, it returns
+// false if the content of the buffer has been corrupted while mapped.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUnmapBuffer.xhtml
+func UnmapBuffer(target Enum) bool {
+	return gl.UnmapBuffer(uint32(target))
+}
+
 // UseProgram sets the active program.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glUseProgram.xhtml
@@ -1417,6 +2287,36 @@ func VertexAttrib4fv(dst Attrib, src []float32) {
 	gl.VertexAttrib4fv(uint32(dst), &src[0])
 }
 
+// VertexAttribDivisor modifies the rate at which generic vertex attributes
+// advance during instanced rendering.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glVertexAttribDivisor.xhtml
+func VertexAttribDivisor(index Attrib, divisor int) {
+	gl.VertexAttribDivisor(uint32(index), uint32(divisor))
+}
+
+// VertexAttribI4i writes an ivec4 vertex attribute.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glVertexAttrib.xhtml
+func VertexAttribI4i(dst Attrib, x, y, z, w int32) {
+	gl.VertexAttribI4i(uint32(dst), x, y, z, w)
+}
+
+// VertexAttribI4ui writes an uvec4 vertex attribute.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glVertexAttrib.xhtml
+func VertexAttribI4ui(dst Attrib, x, y, z, w uint32) {
+	gl.VertexAttribI4ui(uint32(dst), x, y, z, w)
+}
+
+// VertexAttribIPointer uses a bound buffer to define integer vertex attribute data,
+// values are fed to the shader as integers without conversion to float.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glVertexAttribPointer.xhtml
+func VertexAttribIPointer(dst Attrib, size int, ty Enum, stride, offset int) {
+	gl.VertexAttribIPointer(uint32(dst), int32(size), uint32(ty), int32(stride), gl.PtrOffset(offset))
+}
+
 // VertexAttribPointer uses a bound buffer to define vertex attribute data.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glVertexAttribPointer.xhtml
@@ -1424,6 +2324,14 @@ func VertexAttribPointer(dst Attrib, size int, ty Enum, normalized bool, stride,
 	gl.VertexAttribPointer(uint32(dst), int32(size), uint32(ty), normalized, int32(stride), gl.PtrOffset(offset))
 }
 
+// WaitSync instructs the GL server to wait until the sync object s
+// is signaled, timeout must be TIMEOUT_IGNORED.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glWaitSync.xhtml
+func WaitSync(s Sync, flags Enum, timeout uint64) {
+	gl.WaitSync(uintptr(s), uint32(flags), timeout)
+}
+
 // Viewport sets the viewport, an affine transformation that
 // normalizes device coordinates to window coordinates.
 //
@@ -2,6 +2,7 @@
 // Copyright 2014 The Go Authors.  All rights reserved.
 
 // +build android ios
+// +build !tgegldebug
 
 package gl
 
@@ -17,6 +18,21 @@ type plugin struct {
 	glContext gl.Context
 }
 
+// glContext3 lists the OpenGL ES 3 calls implemented by the tge-mobile
+// context but not exposed by gl.Context3
+type glContext3 interface {
+	UniformMatrix2x3fv(dst gl.Uniform, src []float32)
+	UniformMatrix3x2fv(dst gl.Uniform, src []float32)
+	UniformMatrix2x4fv(dst gl.Uniform, src []float32)
+	UniformMatrix4x2fv(dst gl.Uniform, src []float32)
+	UniformMatrix3x4fv(dst gl.Uniform, src []float32)
+	UniformMatrix4x3fv(dst gl.Uniform, src []float32)
+	Uniform1ui(dst gl.Uniform, v uint32)
+	Uniform2ui(dst gl.Uniform, v0, v1 uint32)
+	Uniform3ui(dst gl.Uniform, v0, v1, v2 uint)
+	Uniform4ui(dst gl.Uniform, v0, v1, v2, v3 uint32)
+}
+
 func (p *plugin) Init(runtime tge.Runtime) error {
 	renderer := runtime.GetRenderer()
 	switch renderer.(type) {
@@ -33,15 +49,65 @@ func (p *plugin) Dispose() {
 	FlushCache()
 }
 
+// getFloat32Slice wraps size float32 values starting at src in a slice without copy
+func getFloat32Slice(size int, src unsafe.Pointer) []float32 {
+	return (*[1 << 28]float32)(src)[:size:size]
+}
+
 // GetGLSLVersion gives the glsl version ti put in #version ${VERSION}
 func GetGLSLVersion() string {
 	return "300 es"
 }
 
+// programBinarySupported indicates if GetProgramBinary and ProgramBinary are available
+const programBinarySupported = false
+
+// uniformBlockSupported indicates if GetActiveUniformsiv can report uniforms blocks
+const uniformBlockSupported = false
+
 // FlushCache free memory cache, should be called between scenes
 func FlushCache() {
 	byteArrayBuffer = make([]byte, 0)
 	byteArrayBufferExtendFactor = 1
+
+	for k := range elementArrayShadowMap {
+		delete(elementArrayShadowMap, k)
+	}
+	flushPolygonMode()
+}
+
+// Copies of the element array buffers data, GLES3 cannot read buffers back and
+// PolygonMode LINE needs the indices to draw the edges of triangles
+var elementArrayShadowMap = make(map[Buffer][]byte)
+
+// shadowBufferData keeps a copy of data if target is ELEMENT_ARRAY_BUFFER
+func shadowBufferData(target Enum, data []byte) {
+	if target != ELEMENT_ARRAY_BUFFER {
+		return
+	}
+	if b := Buffer(GetInteger(ELEMENT_ARRAY_BUFFER_BINDING)); b != polygonModeBuffer {
+		elementArrayShadowMap[b] = append([]byte(nil), data...)
+	}
+}
+
+// shadowBufferSubData updates the copy of data if target is ELEMENT_ARRAY_BUFFER
+func shadowBufferSubData(target Enum, offset int, data []byte) {
+	if target != ELEMENT_ARRAY_BUFFER {
+		return
+	}
+	if shadow, found := elementArrayShadowMap[Buffer(GetInteger(ELEMENT_ARRAY_BUFFER_BINDING))]; found && offset+len(data) <= len(shadow) {
+		copy(shadow[offset:], data)
+	}
+}
+
+// readElementArray returns size bytes at offset of the bound element array from
+// its copy, nil if there is no copy or the range exceeds its size
+func readElementArray(offset, size int) []byte {
+	shadow := elementArrayShadowMap[Buffer(GetInteger(ELEMENT_ARRAY_BUFFER_BINDING))]
+	if offset < 0 || offset+size > len(shadow) {
+		return nil
+	}
+	return shadow[offset : offset+size]
 }
 
 // ActiveTexture sets the active texture unit.
@@ -59,6 +125,20 @@ func AttachShader(p Program, s Shader) {
 	_pluginInstance.glContext.AttachShader(gl.Program{Init: true, Value: uint32(p)}, gl.Shader{uint32(s)})
 }
 
+// BeginQuery delimits the start of a query object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glBeginQuery.xhtml
+func BeginQuery(target Enum, q Query) {
+	fmt.Printf("WARNING: BeginQuery not implemented\n")
+}
+
+// BeginTransformFeedback starts transform feedback operation.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glBeginTransformFeedback.xhtml
+func BeginTransformFeedback(primitiveMode Enum) {
+	fmt.Printf("WARNING: BeginTransformFeedback not implemented\n")
+}
+
 // BindAttribLocation binds a vertex attribute index with a named
 // variable.
 //
@@ -74,6 +154,20 @@ func BindBuffer(target Enum, b Buffer) {
 	_pluginInstance.glContext.BindBuffer(gl.Enum(target), gl.Buffer{uint32(b)})
 }
 
+// BindBufferBase binds a buffer to an indexed buffer target.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glBindBufferBase.xhtml
+func BindBufferBase(target Enum, index uint32, b Buffer) {
+	fmt.Printf("WARNING: BindBufferBase not implemented\n")
+}
+
+// BindBufferRange binds a range within a buffer to an indexed buffer target.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glBindBufferRange.xhtml
+func BindBufferRange(target Enum, index uint32, b Buffer, offset, size int) {
+	fmt.Printf("WARNING: BindBufferRange not implemented\n")
+}
+
 // BindFramebuffer binds a framebuffer.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glBindFramebuffer.xhtml
@@ -88,6 +182,14 @@ func BindRenderbuffer(target Enum, rb Renderbuffer) {
 	_pluginInstance.glContext.BindRenderbuffer(gl.Enum(target), gl.Renderbuffer{uint32(rb)})
 }
 
+// BindSampler binds a sampler object to the texture unit, it overrides the
+// sampling state of the texture bound to this unit.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glBindSampler.xhtml
+func BindSampler(unit uint32, s Sampler) {
+	fmt.Printf("WARNING: BindSampler not implemented\n")
+}
+
 // BindTexture binds a texture.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glBindTexture.xhtml
@@ -95,6 +197,13 @@ func BindTexture(target Enum, t Texture) {
 	_pluginInstance.glContext.BindTexture(gl.Enum(target), gl.Texture{uint32(t)})
 }
 
+// BindTransformFeedback binds a transform feedback object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glBindTransformFeedback.xhtml
+func BindTransformFeedback(target Enum, tf TransformFeedback) {
+	fmt.Printf("WARNING: BindTransformFeedback not implemented\n")
+}
+
 // BindVertexArray binds a vertex array.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glBindVertexArray.xhtml
@@ -137,11 +246,24 @@ func BlendFuncSeparate(sfactorRGB, dfactorRGB, sfactorAlpha, dfactorAlpha Enum)
 	_pluginInstance.glContext.BlendFuncSeparate(gl.Enum(sfactorRGB), gl.Enum(dfactorRGB), gl.Enum(sfactorAlpha), gl.Enum(dfactorAlpha))
 }
 
+// BlitFramebuffer copies a block of pixels from the read framebuffer to the
+// draw framebuffer, it is used to resolve multisampled framebuffers.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glBlitFramebuffer.xhtml
+func BlitFramebuffer(srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1 int, mask, filter Enum) {
+	if glContext3, ok := _pluginInstance.glContext.(gl.Context3); ok {
+		glContext3.BlitFramebuffer(srcX0, srcY0, srcX1, srcY1, dstX0, dstY0, dstX1, dstY1, uint(mask), gl.Enum(filter))
+	} else {
+		fmt.Printf("WARNING: BlitFramebuffer not implemented\n")
+	}
+}
+
 // BufferData creates a new data store for the bound buffer object.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glBufferData.xhtml
 func BufferData(target Enum, src []byte, usage Enum) {
 	_pluginInstance.glContext.BufferData(gl.Enum(target), src, gl.Enum(usage))
+	shadowBufferData(target, src)
 }
 
 // BufferInit creates a new unitialized data store for the bound buffer object.
@@ -149,6 +271,7 @@ func BufferData(target Enum, src []byte, usage Enum) {
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glBufferData.xhtml
 func BufferInit(target Enum, size int, usage Enum) {
 	_pluginInstance.glContext.BufferInit(gl.Enum(target), size, gl.Enum(usage))
+	shadowBufferData(target, make([]byte, size))
 }
 
 // BufferSubData sets some of data in the bound buffer object.
@@ -156,6 +279,7 @@ func BufferInit(target Enum, size int, usage Enum) {
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glBufferSubData.xhtml
 func BufferSubData(target Enum, offset int, data []byte) {
 	_pluginInstance.glContext.BufferSubData(gl.Enum(target), offset, data)
+	shadowBufferSubData(target, offset, data)
 }
 
 // CheckFramebufferStatus reports the completeness status of the
@@ -176,6 +300,35 @@ func Clear(mask Enum) {
 	_pluginInstance.glContext.Clear(gl.Enum(mask))
 }
 
+// ClearBufferfi clears the depth and stencil buffers of the current draw
+// framebuffer at once, buffer must be DEPTH_STENCIL.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glClearBuffer.xhtml
+func ClearBufferfi(buffer Enum, drawbuffer int, depth float32, stencil int) {
+	fmt.Printf("WARNING: ClearBufferfi not implemented\n")
+}
+
+// ClearBufferfv clears a float buffer of the current draw framebuffer.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glClearBuffer.xhtml
+func ClearBufferfv(buffer Enum, drawbuffer int, value []float32) {
+	fmt.Printf("WARNING: ClearBufferfv not implemented\n")
+}
+
+// ClearBufferiv clears a signed integer buffer of the current draw framebuffer.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glClearBuffer.xhtml
+func ClearBufferiv(buffer Enum, drawbuffer int, value []int32) {
+	fmt.Printf("WARNING: ClearBufferiv not implemented\n")
+}
+
+// ClearBufferuiv clears an unsigned integer buffer of the current draw framebuffer.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glClearBuffer.xhtml
+func ClearBufferuiv(buffer Enum, drawbuffer int, value []uint32) {
+	fmt.Printf("WARNING: ClearBufferuiv not implemented\n")
+}
+
 // ClearColor specifies the RGBA values used to clear color buffers.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glClearColor.xhtml
@@ -197,6 +350,15 @@ func ClearStencil(s int) {
 	_pluginInstance.glContext.ClearStencil(s)
 }
 
+// ClientWaitSync blocks until the sync object s is signaled or
+// timeout (in nanoseconds) expires, a zero timeout only polls s.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glClientWaitSync.xhtml
+func ClientWaitSync(s Sync, flags Enum, timeout uint64) Enum {
+	fmt.Printf("WARNING: ClientWaitSync not implemented\n")
+	return WAIT_FAILED
+}
+
 // ColorMask specifies whether color components in the framebuffer
 // can be written.
 //
@@ -219,6 +381,13 @@ func CompressedTexImage2D(target Enum, level int, internalformat Enum, width, he
 	_pluginInstance.glContext.CompressedTexImage2D(gl.Enum(target), level, gl.Enum(internalformat), width, height, border, data)
 }
 
+// CompressedTexImage3D writes a compressed 3D texture or 2D texture array.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glCompressedTexImage3D.xhtml
+func CompressedTexImage3D(target Enum, level int, internalformat Enum, width, height, depth, border int, data []byte) {
+	fmt.Printf("WARNING: CompressedTexImage3D not implemented\n")
+}
+
 // CompressedTexSubImage2D writes a subregion of a compressed 2D texture.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glCompressedTexSubImage2D.xhtml
@@ -226,6 +395,22 @@ func CompressedTexSubImage2D(target Enum, level, xoffset, yoffset, width, height
 	_pluginInstance.glContext.CompressedTexSubImage2D(gl.Enum(target), level, xoffset, yoffset, width, height, gl.Enum(format), data)
 }
 
+// CompressedTexSubImage3D writes a subregion of a compressed 3D texture
+// or 2D texture array.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glCompressedTexSubImage3D.xhtml
+func CompressedTexSubImage3D(target Enum, level, xoffset, yoffset, zoffset, width, height, depth int, format Enum, data []byte) {
+	fmt.Printf("WARNING: CompressedTexSubImage3D not implemented\n")
+}
+
+// CopyBufferSubData copies part of the data store of the buffer bound to readTarget
+// into the buffer bound to writeTarget.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glCopyBufferSubData.xhtml
+func CopyBufferSubData(readTarget, writeTarget Enum, readOffset, writeOffset, size int) {
+	fmt.Printf("WARNING: CopyBufferSubData not implemented\n")
+}
+
 // CopyTexImage2D writes a 2D texture from the current framebuffer.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glCopyTexImage2D.xhtml
@@ -241,6 +426,14 @@ func CopyTexSubImage2D(target Enum, level, xoffset, yoffset, x, y, width, height
 	_pluginInstance.glContext.CopyTexSubImage2D(gl.Enum(target), level, xoffset, yoffset, x, y, width, height)
 }
 
+// CopyTexSubImage3D writes a subregion of a 3D texture or 2D texture
+// array layer from the current framebuffer.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glCopyTexSubImage3D.xhtml
+func CopyTexSubImage3D(target Enum, level, xoffset, yoffset, zoffset, x, y, width, height int) {
+	fmt.Printf("WARNING: CopyTexSubImage3D not implemented\n")
+}
+
 // CreateBuffer creates a buffer object.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGenBuffers.xhtml
@@ -262,6 +455,14 @@ func CreateProgram() Program {
 	return Program(_pluginInstance.glContext.CreateProgram().Value)
 }
 
+// CreateQuery creates a query object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGenQueries.xhtml
+func CreateQuery() Query {
+	fmt.Printf("WARNING: CreateQuery not implemented\n")
+	return NONE
+}
+
 // CreateRenderbuffer create a renderbuffer object.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGenRenderbuffers.xhtml
@@ -269,6 +470,14 @@ func CreateRenderbuffer() Renderbuffer {
 	return Renderbuffer(_pluginInstance.glContext.CreateRenderbuffer().Value)
 }
 
+// CreateSampler creates a sampler object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGenSamplers.xhtml
+func CreateSampler() Sampler {
+	fmt.Printf("WARNING: CreateSampler not implemented\n")
+	return NONE
+}
+
 // CreateShader creates a new empty shader object.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glCreateShader.xhtml
@@ -283,6 +492,14 @@ func CreateTexture() Texture {
 	return Texture(_pluginInstance.glContext.CreateTexture().Value)
 }
 
+// CreateTransformFeedback creates a transform feedback object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGenTransformFeedbacks.xhtml
+func CreateTransformFeedback() TransformFeedback {
+	fmt.Printf("WARNING: CreateTransformFeedback not implemented\n")
+	return NONE
+}
+
 // CreateTVertexArray creates a vertex array.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGenVertexArrays.xhtml
@@ -304,6 +521,7 @@ func CullFace(mode Enum) {
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glDeleteBuffers.xhtml
 func DeleteBuffer(v Buffer) {
 	_pluginInstance.glContext.DeleteBuffer(gl.Buffer{uint32(v)})
+	delete(elementArrayShadowMap, v)
 }
 
 // DeleteFramebuffer deletes the given framebuffer object.
@@ -320,6 +538,13 @@ func DeleteProgram(p Program) {
 	_pluginInstance.glContext.DeleteProgram(gl.Program{Init: true, Value: uint32(p)})
 }
 
+// DeleteQuery deletes the given query object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glDeleteQueries.xhtml
+func DeleteQuery(v Query) {
+	fmt.Printf("WARNING: DeleteQuery not implemented\n")
+}
+
 // DeleteRenderbuffer deletes the given render buffer object.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glDeleteRenderbuffers.xhtml
@@ -327,6 +552,13 @@ func DeleteRenderbuffer(v Renderbuffer) {
 	_pluginInstance.glContext.DeleteRenderbuffer(gl.Renderbuffer{uint32(v)})
 }
 
+// DeleteSampler deletes the given sampler object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glDeleteSamplers.xhtml
+func DeleteSampler(v Sampler) {
+	fmt.Printf("WARNING: DeleteSampler not implemented\n")
+}
+
 // DeleteShader deletes shader s.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glDeleteShader.xhtml
@@ -334,6 +566,13 @@ func DeleteShader(s Shader) {
 	_pluginInstance.glContext.DeleteShader(gl.Shader{uint32(s)})
 }
 
+// DeleteSync deletes the given sync object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glDeleteSync.xhtml
+func DeleteSync(s Sync) {
+	fmt.Printf("WARNING: DeleteSync not implemented\n")
+}
+
 // DeleteTexture deletes the given texture object.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glDeleteTextures.xhtml
@@ -341,6 +580,13 @@ func DeleteTexture(v Texture) {
 	_pluginInstance.glContext.DeleteTexture(gl.Texture{uint32(v)})
 }
 
+// DeleteTransformFeedback deletes the given transform feedback object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glDeleteTransformFeedbacks.xhtml
+func DeleteTransformFeedback(v TransformFeedback) {
+	fmt.Printf("WARNING: DeleteTransformFeedback not implemented\n")
+}
+
 // DeleteVertexArray deletes the given render buffer object.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glDeleteVertexArrays.xhtml
@@ -405,16 +651,45 @@ func DisableVertexAttribArray(a Attrib) {
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glDrawArrays.xhtml
 func DrawArrays(mode Enum, first, count int) {
+	if isPolygonModeLine(mode) {
+		drawArraysAsLines(mode, first, count)
+		return
+	}
 	_pluginInstance.glContext.DrawArrays(gl.Enum(mode), first, count)
 }
 
+// DrawArraysInstanced renders multiple instances of primitives from the bound data.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glDrawArraysInstanced.xhtml
+func DrawArraysInstanced(mode Enum, first, count, primcount int) {
+	fmt.Printf("WARNING: DrawArraysInstanced not implemented\n")
+}
+
+// DrawBuffers specifies the list of color buffers to be drawn into.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glDrawBuffers.xhtml
+func DrawBuffers(bufs []Enum) {
+	fmt.Printf("WARNING: DrawBuffers not implemented\n")
+}
+
 // DrawElements renders primitives from a bound buffer.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glDrawElements.xhtml
 func DrawElements(mode Enum, count int, ty Enum, offset int) {
+	if isPolygonModeLine(mode) {
+		drawElementsAsLines(mode, count, ty, offset)
+		return
+	}
 	_pluginInstance.glContext.DrawElements(gl.Enum(mode), count, gl.Enum(ty), offset)
 }
 
+// DrawElementsInstanced renders multiple instances of primitives from a bound buffer.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glDrawElementsInstanced.xhtml
+func DrawElementsInstanced(mode Enum, count int, ty Enum, offset, primcount int) {
+	fmt.Printf("WARNING: DrawElementsInstanced not implemented\n")
+}
+
 // Enable enables various GL capabilities.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glEnable.xhtml
@@ -429,6 +704,28 @@ func EnableVertexAttribArray(a Attrib) {
 	_pluginInstance.glContext.EnableVertexAttribArray(gl.Attrib{uint(a)})
 }
 
+// EndQuery delimits the end of a query object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glBeginQuery.xhtml
+func EndQuery(target Enum) {
+	fmt.Printf("WARNING: EndQuery not implemented\n")
+}
+
+// EndTransformFeedback ends transform feedback operation.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glBeginTransformFeedback.xhtml
+func EndTransformFeedback() {
+	fmt.Printf("WARNING: EndTransformFeedback not implemented\n")
+}
+
+// FenceSync creates a new sync object and inserts it into the GL command stream.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glFenceSync.xhtml
+func FenceSync(condition, flags Enum) Sync {
+	fmt.Printf("WARNING: FenceSync not implemented\n")
+	return NONE
+}
+
 // Finish blocks until the effects of all previously called GL
 // commands are complete.
 //
@@ -447,6 +744,14 @@ func Flush() {
 	_pluginInstance.glContext.Flush()
 }
 
+// FlushMappedBufferRange indicates modifications to a range of a mapped buffer,
+// offset is relative to the start of the mapped range.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glFlushMappedBufferRange.xhtml
+func FlushMappedBufferRange(target Enum, offset, length int) {
+	fmt.Printf("WARNING: FlushMappedBufferRange not implemented\n")
+}
+
 // FramebufferRenderbuffer attaches rb to the current frame buffer.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glFramebufferRenderbuffer.xhtml
@@ -461,6 +766,14 @@ func FramebufferTexture2D(target, attachment, texTarget Enum, t Texture, level i
 	_pluginInstance.glContext.FramebufferTexture2D(gl.Enum(target), gl.Enum(attachment), gl.Enum(texTarget), gl.Texture{uint32(t)}, level)
 }
 
+// FramebufferTextureLayer attaches a single layer of the 3D texture or 2D texture
+// array t to the current frame buffer.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glFramebufferTextureLayer.xhtml
+func FramebufferTextureLayer(target, attachment Enum, t Texture, level, layer int) {
+	fmt.Printf("WARNING: FramebufferTextureLayer not implemented\n")
+}
+
 // FrontFace defines which polygons are front-facing.
 //
 // Valid modes: CW, CCW.
@@ -488,6 +801,21 @@ func GetActiveAttrib(p Program, index uint32) (name string, size int, ty Enum) {
 	return n, s, Enum(t)
 }
 
+// GetActiveUniformBlockiv returns information about an active uniform block.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetActiveUniformBlockiv.xhtml
+func GetActiveUniformBlockiv(dst []int32, p Program, index UniformBlock, pname Enum) {
+	fmt.Printf("WARNING: GetActiveUniformBlockiv not implemented\n")
+}
+
+// GetActiveUniformBlockName returns the name of an active uniform block.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetActiveUniformBlockName.xhtml
+func GetActiveUniformBlockName(p Program, index UniformBlock) string {
+	fmt.Printf("WARNING: GetActiveUniformBlockName not implemented\n")
+	return ""
+}
+
 // GetActiveUniform returns details about an active uniform variable.
 // A value of 0 for index selects the first active uniform variable.
 // Permissible values for index range from 0 to the number of active
@@ -499,6 +827,14 @@ func GetActiveUniform(p Program, index uint32) (name string, size int, ty Enum)
 	return n, s, Enum(t)
 }
 
+// GetActiveUniformsiv returns a parameter of several active uniforms at once,
+// dst must be at least len(indices) long.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetActiveUniformsiv.xhtml
+func GetActiveUniformsiv(dst []int32, p Program, indices []uint32, pname Enum) {
+	fmt.Printf("WARNING: GetActiveUniformsiv not implemented\n")
+}
+
 // GetAttachedShaders returns the shader objects attached to program p.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGetAttachedShaders.xhtml
@@ -557,6 +893,16 @@ func GetBufferParameteri(target, pname Enum) int {
 	return _pluginInstance.glContext.GetBufferParameteri(gl.Enum(target), gl.Enum(pname))
 }
 
+// GetBufferSubData reads len(dst) bytes of the bound buffer object starting at offset.
+//
+// GLES3 has no glGetBufferSubData and tge-mobile does not expose glMapBufferRange,
+// dst is left unchanged.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glMapBufferRange.xhtml
+func GetBufferSubData(target Enum, offset int, dst []byte) {
+	fmt.Printf("WARNING: GetBufferSubData not implemented\n")
+}
+
 // GetError returns the next error.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGetError.xhtml
@@ -573,6 +919,15 @@ func GetBoundFramebuffer() Framebuffer {
 	return Framebuffer(uint32(b[0]))
 }
 
+// GetFragDataLocation returns the color number bound to a fragment shader output
+// variable, -1 if name is not an output of the program.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetFragDataLocation.xhtml
+func GetFragDataLocation(p Program, name string) int {
+	fmt.Printf("WARNING: GetFragDataLocation not implemented\n")
+	return -1
+}
+
 // GetFramebufferAttachmentParameteri returns attachment parameters
 // for the active framebuffer object.
 //
@@ -581,6 +936,15 @@ func GetFramebufferAttachmentParameteri(target, attachment, pname Enum) int {
 	return _pluginInstance.glContext.GetFramebufferAttachmentParameteri(gl.Enum(target), gl.Enum(attachment), gl.Enum(pname))
 }
 
+// GetProgramBinary returns the binary representation of a linked program,
+// data is empty if the driver does not provide it.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetProgramBinary.xhtml
+func GetProgramBinary(p Program) (data []byte, format Enum) {
+	fmt.Printf("WARNING: GetProgramBinary not implemented\n")
+	return nil, NONE
+}
+
 // GetProgrami returns a parameter value for a program.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGetProgramiv.xhtml
@@ -595,6 +959,25 @@ func GetProgramInfoLog(p Program) string {
 	return _pluginInstance.glContext.GetProgramInfoLog(gl.Program{Init: true, Value: uint32(p)})
 }
 
+// GetQueryi returns a parameter value for a query target.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetQueryiv.xhtml
+func GetQueryi(target, pname Enum) int {
+	fmt.Printf("WARNING: GetQueryi not implemented\n")
+	return 0
+}
+
+// GetQueryObjectui returns a parameter value for a query object.
+//
+// Querying QUERY_RESULT waits for the result, use PollQueryResult
+// to avoid stalling.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetQueryObjectuiv.xhtml
+func GetQueryObjectui(q Query, pname Enum) uint32 {
+	fmt.Printf("WARNING: GetQueryObjectui not implemented\n")
+	return 0
+}
+
 // GetRenderbufferParameteri returns a parameter value for a render buffer.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGetRenderbufferParameteriv.xhtml
@@ -602,6 +985,20 @@ func GetRenderbufferParameteri(target, pname Enum) int {
 	return _pluginInstance.glContext.GetRenderbufferParameteri(gl.Enum(target), gl.Enum(pname))
 }
 
+// GetSamplerParameterfv returns the float values of a sampler parameter.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetSamplerParameter.xhtml
+func GetSamplerParameterfv(dst []float32, s Sampler, pname Enum) {
+	fmt.Printf("WARNING: GetSamplerParameterfv not implemented\n")
+}
+
+// GetSamplerParameteriv returns the int values of a sampler parameter.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetSamplerParameter.xhtml
+func GetSamplerParameteriv(dst []int32, s Sampler, pname Enum) {
+	fmt.Printf("WARNING: GetSamplerParameteriv not implemented\n")
+}
+
 // GetRenderbufferParameteri returns a parameter value for a shader.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGetShaderiv.xhtml
@@ -645,6 +1042,13 @@ func GetString(pname Enum) string {
 	return _pluginInstance.glContext.GetString(gl.Enum(pname))
 }
 
+// GetSynciv returns the properties of a sync object.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetSynciv.xhtml
+func GetSynciv(dst []int32, s Sync, pname Enum) {
+	fmt.Printf("WARNING: GetSynciv not implemented\n")
+}
+
 // GetTexParameterfv returns the float values of a texture parameter.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGetTexParameter.xhtml
@@ -659,6 +1063,15 @@ func GetTexParameteriv(dst []int32, target, pname Enum) {
 	_pluginInstance.glContext.GetTexParameteriv(dst, gl.Enum(target), gl.Enum(pname))
 }
 
+// GetTransformFeedbackVarying returns details about a varying variable
+// selected for transform feedback.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetTransformFeedbackVarying.xhtml
+func GetTransformFeedbackVarying(p Program, index uint32) (name string, size int, ty Enum) {
+	fmt.Printf("WARNING: GetTransformFeedbackVarying not implemented\n")
+	return "", 0, NONE
+}
+
 // GetUniformfv returns the float values of a uniform variable.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGetUniform.xhtml
@@ -673,6 +1086,23 @@ func GetUniformiv(dst []int32, src Uniform, p Program) {
 	_pluginInstance.glContext.GetUniformiv(dst, gl.Uniform{int32(src)}, gl.Program{Init: true, Value: uint32(p)})
 }
 
+// GetUniformBlockIndex returns the index of a named uniform block.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetUniformBlockIndex.xhtml
+func GetUniformBlockIndex(p Program, name string) UniformBlock {
+	fmt.Printf("WARNING: GetUniformBlockIndex not implemented\n")
+	return INVALID_INDEX
+}
+
+// GetUniformIndices returns the indices of the given uniforms, INVALID_INDEX is set
+// for names which are not active uniforms of the program.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetUniformIndices.xhtml
+func GetUniformIndices(p Program, names []string) []uint32 {
+	fmt.Printf("WARNING: GetUniformIndices not implemented\n")
+	return nil
+}
+
 // GetUniformLocation returns the location of a uniform variable.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glGetUniformLocation.xhtml
@@ -708,6 +1138,13 @@ func GetVertexAttribiv(dst []int32, src Attrib, pname Enum) {
 	_pluginInstance.glContext.GetVertexAttribiv(dst, gl.Attrib{uint(src)}, gl.Enum(pname))
 }
 
+// GetVertexAttribIiv reads int values of an integer vertex attribute.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glGetVertexAttrib.xhtml
+func GetVertexAttribIiv(dst []int32, src Attrib, pname Enum) {
+	fmt.Printf("WARNING: GetVertexAttribIiv not implemented\n")
+}
+
 // Hint sets implementation-specific modes.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glHint.xhtml
@@ -715,6 +1152,22 @@ func Hint(target, mode Enum) {
 	_pluginInstance.glContext.Hint(gl.Enum(target), gl.Enum(mode))
 }
 
+// InvalidateFramebuffer indicates that the content of the given attachments
+// of the bound framebuffer is no longer needed.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glInvalidateFramebuffer.xhtml
+func InvalidateFramebuffer(target Enum, attachments []Enum) {
+	fmt.Printf("WARNING: InvalidateFramebuffer not implemented\n")
+}
+
+// InvalidateSubFramebuffer indicates that the content of a region of the given
+// attachments of the bound framebuffer is no longer needed.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glInvalidateSubFramebuffer.xhtml
+func InvalidateSubFramebuffer(target Enum, attachments []Enum, x, y, width, height int) {
+	fmt.Printf("WARNING: InvalidateSubFramebuffer not implemented\n")
+}
+
 // IsBuffer reports if b is a valid buffer.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glIsBuffer.xhtml
@@ -778,6 +1231,22 @@ func LinkProgram(p Program) {
 	_pluginInstance.glContext.LinkProgram(gl.Program{Init: true, Value: uint32(p)})
 }
 
+// MapBufferRange maps a range of the bound buffer object into client memory,
+// the returned slice is only valid until UnmapBuffer is called.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glMapBufferRange.xhtml
+func MapBufferRange(target Enum, offset, length int, access Enum) []byte {
+	fmt.Printf("WARNING: MapBufferRange not implemented\n")
+	return nil
+}
+
+// PauseTransformFeedback pauses transform feedback operations.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glPauseTransformFeedback.xhtml
+func PauseTransformFeedback() {
+	fmt.Printf("WARNING: PauseTransformFeedback not implemented\n")
+}
+
 // PixelStorei sets pixel storage parameters.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glPixelStorei.xhtml
@@ -794,9 +1263,35 @@ func PolygonOffset(factor, units float32) {
 
 // PolygonMode sets Polygon Mode.
 //
+// GLES3 has no polygon mode, LINE is emulated by drawing the edges of triangles
+// with LINES, face is ignored and POINT is not supported.
+//
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glPolygonMode.xhtml
 func PolygonMode(face, mode Enum) {
-	fmt.Printf("WARNING: PolygonMode not implemented\n")
+	setPolygonMode(mode)
+}
+
+// ProgramBinary loads a program from a binary previously returned by GetProgramBinary,
+// LINK_STATUS must be checked as drivers may reject outdated binaries.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glProgramBinary.xhtml
+func ProgramBinary(p Program, format Enum, data []byte) {
+	fmt.Printf("WARNING: ProgramBinary not implemented\n")
+}
+
+// ProgramParameteri sets a parameter of program p, PROGRAM_BINARY_RETRIEVABLE_HINT
+// must be set to TRUE before linking to retrieve the program binary.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glProgramParameteri.xhtml
+func ProgramParameteri(p Program, pname Enum, value int) {
+	fmt.Printf("WARNING: ProgramParameteri not implemented\n")
+}
+
+// ReadBuffer selects the color buffer source for pixels read operations.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glReadBuffer.xhtml
+func ReadBuffer(src Enum) {
+	fmt.Printf("WARNING: ReadBuffer not implemented\n")
 }
 
 // ReadPixels returns pixel data from a buffer.
@@ -821,6 +1316,21 @@ func RenderbufferStorage(target, internalFormat Enum, width, height int) {
 	_pluginInstance.glContext.RenderbufferStorage(gl.Enum(target), gl.Enum(internalFormat), width, height)
 }
 
+// RenderbufferStorageMultisample establishes a multisampled data storage, format,
+// and dimensions of a renderbuffer object's image.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glRenderbufferStorageMultisample.xhtml
+func RenderbufferStorageMultisample(target Enum, samples int, internalFormat Enum, width, height int) {
+	fmt.Printf("WARNING: RenderbufferStorageMultisample not implemented\n")
+}
+
+// ResumeTransformFeedback resumes transform feedback operations.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glResumeTransformFeedback.xhtml
+func ResumeTransformFeedback() {
+	fmt.Printf("WARNING: ResumeTransformFeedback not implemented\n")
+}
+
 // SampleCoverage sets multisample coverage parameters.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glSampleCoverage.xhtml
@@ -828,6 +1338,34 @@ func SampleCoverage(value float32, invert bool) {
 	_pluginInstance.glContext.SampleCoverage(value, invert)
 }
 
+// SamplerParameterf sets a float sampler parameter.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glSamplerParameter.xhtml
+func SamplerParameterf(s Sampler, pname Enum, param float32) {
+	fmt.Printf("WARNING: SamplerParameterf not implemented\n")
+}
+
+// SamplerParameterfv sets a float sampler parameter array.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glSamplerParameter.xhtml
+func SamplerParameterfv(s Sampler, pname Enum, params []float32) {
+	fmt.Printf("WARNING: SamplerParameterfv not implemented\n")
+}
+
+// SamplerParameteri sets an integer sampler parameter.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glSamplerParameter.xhtml
+func SamplerParameteri(s Sampler, pname Enum, param int) {
+	fmt.Printf("WARNING: SamplerParameteri not implemented\n")
+}
+
+// SamplerParameteriv sets an integer sampler parameter array.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glSamplerParameter.xhtml
+func SamplerParameteriv(s Sampler, pname Enum, params []int32) {
+	fmt.Printf("WARNING: SamplerParameteriv not implemented\n")
+}
+
 // Scissor defines the scissor box rectangle, in window coordinates.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glScissor.xhtml
@@ -891,6 +1429,21 @@ func TexImage2D(target Enum, level int, width, height int, format Enum, ty Enum,
 	_pluginInstance.glContext.TexImage2D(gl.Enum(target), level, int(format), width, height, gl.Enum(format), gl.Enum(ty), data)
 }
 
+// TexImage2DInternal writes a 2D texture image using a distinct internal format,
+// it allows sized internal formats like RGBA16F, R32F or DEPTH_COMPONENT24.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glTexImage2D.xhtml
+func TexImage2DInternal(target Enum, level int, internalFormat Enum, width, height int, format Enum, ty Enum, data []byte) {
+	_pluginInstance.glContext.TexImage2D(gl.Enum(target), level, int(internalFormat), width, height, gl.Enum(format), gl.Enum(ty), data)
+}
+
+// TexImage3D writes a 3D texture or 2D texture array image.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glTexImage3D.xhtml
+func TexImage3D(target Enum, level int, width, height, depth int, format Enum, ty Enum, data []byte) {
+	fmt.Printf("WARNING: TexImage3D not implemented\n")
+}
+
 // TexSubImage2D writes a subregion of a 2D texture image.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glTexSubImage2D.xhtml
@@ -898,6 +1451,13 @@ func TexSubImage2D(target Enum, level int, x, y, width, height int, format, ty E
 	_pluginInstance.glContext.TexSubImage2D(gl.Enum(target), level, x, y, width, height, gl.Enum(format), gl.Enum(ty), data)
 }
 
+// TexSubImage3D writes a subregion of a 3D texture or 2D texture array image.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glTexSubImage3D.xhtml
+func TexSubImage3D(target Enum, level int, x, y, z, width, height, depth int, format, ty Enum, data []byte) {
+	fmt.Printf("WARNING: TexSubImage3D not implemented\n")
+}
+
 // TexParameterf sets a float texture parameter.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glTexParameter.xhtml
@@ -926,6 +1486,29 @@ func TexParameteriv(target, pname Enum, params []int32) {
 	_pluginInstance.glContext.TexParameteriv(gl.Enum(target), gl.Enum(pname), params)
 }
 
+// TexStorage2D allocates an immutable storage for all levels of a 2D texture.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glTexStorage2D.xhtml
+func TexStorage2D(target Enum, levels int, internalformat Enum, width, height int) {
+	fmt.Printf("WARNING: TexStorage2D not implemented\n")
+}
+
+// TexStorage3D allocates an immutable storage for all levels of a 3D texture
+// or 2D texture array.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glTexStorage3D.xhtml
+func TexStorage3D(target Enum, levels int, internalformat Enum, width, height, depth int) {
+	fmt.Printf("WARNING: TexStorage3D not implemented\n")
+}
+
+// TransformFeedbackVaryings specifies values to record in transform
+// feedback buffers.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glTransformFeedbackVaryings.xhtml
+func TransformFeedbackVaryings(p Program, varyings []string, bufferMode Enum) {
+	fmt.Printf("WARNING: TransformFeedbackVaryings not implemented\n")
+}
+
 // Uniform1f writes a float uniform variable.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
@@ -978,6 +1561,32 @@ func Uniform1ivUP(dst Uniform, count int32, value unsafe.Pointer) {
 	_pluginInstance.glContext.Uniform1ivUP(gl.Uniform{int32(dst)}, count, value)
 }
 
+// Uniform1ui writes a uint uniform variable.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func Uniform1ui(dst Uniform, v uint32) {
+	if ctx3, ok := _pluginInstance.glContext.(glContext3); ok {
+		ctx3.Uniform1ui(gl.Uniform{int32(dst)}, v)
+	} else {
+		fmt.Printf("WARNING: Uniform1ui not implemented\n")
+	}
+}
+
+// Uniform1uiv writes a uint uniform array of len(src) elements.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func Uniform1uiv(dst Uniform, src []uint32) {
+	fmt.Printf("WARNING: Uniform1uiv not implemented\n")
+}
+
+func Uniform1uivP(dst Uniform, count int32, value *uint32) {
+	fmt.Printf("WARNING: Uniform1uivP not implemented\n")
+}
+
+func Uniform1uivUP(dst Uniform, count int32, value unsafe.Pointer) {
+	fmt.Printf("WARNING: Uniform1uivUP not implemented\n")
+}
+
 // Uniform2f writes a vec2 uniform variable.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
@@ -1022,6 +1631,32 @@ func Uniform2ivUP(dst Uniform, count int32, value unsafe.Pointer) {
 	_pluginInstance.glContext.Uniform2ivUP(gl.Uniform{int32(dst)}, count, value)
 }
 
+// Uniform2ui writes a uvec2 uniform variable.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func Uniform2ui(dst Uniform, v0, v1 uint32) {
+	if ctx3, ok := _pluginInstance.glContext.(glContext3); ok {
+		ctx3.Uniform2ui(gl.Uniform{int32(dst)}, v0, v1)
+	} else {
+		fmt.Printf("WARNING: Uniform2ui not implemented\n")
+	}
+}
+
+// Uniform2uiv writes a uvec2 uniform array of len(src)/2 elements.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func Uniform2uiv(dst Uniform, src []uint32) {
+	fmt.Printf("WARNING: Uniform2uiv not implemented\n")
+}
+
+func Uniform2uivP(dst Uniform, count int32, value *uint32) {
+	fmt.Printf("WARNING: Uniform2uivP not implemented\n")
+}
+
+func Uniform2uivUP(dst Uniform, count int32, value unsafe.Pointer) {
+	fmt.Printf("WARNING: Uniform2uivUP not implemented\n")
+}
+
 // Uniform3f writes a vec3 uniform variable.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
@@ -1066,6 +1701,32 @@ func Uniform3ivUP(dst Uniform, count int32, value unsafe.Pointer) {
 	_pluginInstance.glContext.Uniform3ivUP(gl.Uniform{int32(dst)}, count, value)
 }
 
+// Uniform3ui writes a uvec3 uniform variable.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func Uniform3ui(dst Uniform, v0, v1, v2 uint32) {
+	if ctx3, ok := _pluginInstance.glContext.(glContext3); ok {
+		ctx3.Uniform3ui(gl.Uniform{int32(dst)}, uint(v0), uint(v1), uint(v2))
+	} else {
+		fmt.Printf("WARNING: Uniform3ui not implemented\n")
+	}
+}
+
+// Uniform3uiv writes a uvec3 uniform array of len(src)/3 elements.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func Uniform3uiv(dst Uniform, src []uint32) {
+	fmt.Printf("WARNING: Uniform3uiv not implemented\n")
+}
+
+func Uniform3uivP(dst Uniform, count int32, value *uint32) {
+	fmt.Printf("WARNING: Uniform3uivP not implemented\n")
+}
+
+func Uniform3uivUP(dst Uniform, count int32, value unsafe.Pointer) {
+	fmt.Printf("WARNING: Uniform3uivUP not implemented\n")
+}
+
 // Uniform4f writes a vec4 uniform variable.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
@@ -1110,6 +1771,39 @@ func Uniform4ivUP(dst Uniform, count int32, value unsafe.Pointer) {
 	_pluginInstance.glContext.Uniform4ivUP(gl.Uniform{int32(dst)}, count, value)
 }
 
+// Uniform4ui writes a uvec4 uniform variable.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func Uniform4ui(dst Uniform, v0, v1, v2, v3 uint32) {
+	if ctx3, ok := _pluginInstance.glContext.(glContext3); ok {
+		ctx3.Uniform4ui(gl.Uniform{int32(dst)}, v0, v1, v2, v3)
+	} else {
+		fmt.Printf("WARNING: Uniform4ui not implemented\n")
+	}
+}
+
+// Uniform4uiv writes a uvec4 uniform array of len(src)/4 elements.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func Uniform4uiv(dst Uniform, src []uint32) {
+	fmt.Printf("WARNING: Uniform4uiv not implemented\n")
+}
+
+func Uniform4uivP(dst Uniform, count int32, value *uint32) {
+	fmt.Printf("WARNING: Uniform4uivP not implemented\n")
+}
+
+func Uniform4uivUP(dst Uniform, count int32, value unsafe.Pointer) {
+	fmt.Printf("WARNING: Uniform4uivUP not implemented\n")
+}
+
+// UniformBlockBinding assigns a binding point to an active uniform block.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniformBlockBinding.xhtml
+func UniformBlockBinding(p Program, index UniformBlock, binding uint32) {
+	fmt.Printf("WARNING: UniformBlockBinding not implemented\n")
+}
+
 // UniformMatrix2fv writes 2x2 matrices. Each matrix uses four
 // float32 values, so the number of matrices written is len(src)/4.
 //
@@ -1128,6 +1822,50 @@ func UniformMatrix2fvUP(dst Uniform, count int32, transpose bool, value unsafe.P
 	_pluginInstance.glContext.UniformMatrix2fvUP(gl.Uniform{int32(dst)}, count, value)
 }
 
+// UniformMatrix2x3fv writes 2x3 matrices. Each matrix uses six
+// float32 values, so the number of matrices written is len(src)/6.
+//
+// Each matrix must be supplied in column major order.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func UniformMatrix2x3fv(dst Uniform, transpose bool, src []float32) {
+	if ctx3, ok := _pluginInstance.glContext.(glContext3); ok {
+		ctx3.UniformMatrix2x3fv(gl.Uniform{int32(dst)}, src)
+	} else {
+		fmt.Printf("WARNING: UniformMatrix2x3fv not implemented\n")
+	}
+}
+
+func UniformMatrix2x3fvP(dst Uniform, count int32, transpose bool, value *float32) {
+	UniformMatrix2x3fv(dst, transpose, getFloat32Slice(int(count*6), unsafe.Pointer(value)))
+}
+
+func UniformMatrix2x3fvUP(dst Uniform, count int32, transpose bool, value unsafe.Pointer) {
+	UniformMatrix2x3fv(dst, transpose, getFloat32Slice(int(count*6), value))
+}
+
+// UniformMatrix2x4fv writes 2x4 matrices. Each matrix uses eight
+// float32 values, so the number of matrices written is len(src)/8.
+//
+// Each matrix must be supplied in column major order.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func UniformMatrix2x4fv(dst Uniform, transpose bool, src []float32) {
+	if ctx3, ok := _pluginInstance.glContext.(glContext3); ok {
+		ctx3.UniformMatrix2x4fv(gl.Uniform{int32(dst)}, src)
+	} else {
+		fmt.Printf("WARNING: UniformMatrix2x4fv not implemented\n")
+	}
+}
+
+func UniformMatrix2x4fvP(dst Uniform, count int32, transpose bool, value *float32) {
+	UniformMatrix2x4fv(dst, transpose, getFloat32Slice(int(count*8), unsafe.Pointer(value)))
+}
+
+func UniformMatrix2x4fvUP(dst Uniform, count int32, transpose bool, value unsafe.Pointer) {
+	UniformMatrix2x4fv(dst, transpose, getFloat32Slice(int(count*8), value))
+}
+
 // UniformMatrix3fv writes 3x3 matrices. Each matrix uses nine
 // float32 values, so the number of matrices written is len(src)/9.
 //
@@ -1146,6 +1884,50 @@ func UniformMatrix3fvUP(dst Uniform, count int32, transpose bool, value unsafe.P
 	_pluginInstance.glContext.UniformMatrix3fvUP(gl.Uniform{int32(dst)}, count, value)
 }
 
+// UniformMatrix3x2fv writes 3x2 matrices. Each matrix uses six
+// float32 values, so the number of matrices written is len(src)/6.
+//
+// Each matrix must be supplied in column major order.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func UniformMatrix3x2fv(dst Uniform, transpose bool, src []float32) {
+	if ctx3, ok := _pluginInstance.glContext.(glContext3); ok {
+		ctx3.UniformMatrix3x2fv(gl.Uniform{int32(dst)}, src)
+	} else {
+		fmt.Printf("WARNING: UniformMatrix3x2fv not implemented\n")
+	}
+}
+
+func UniformMatrix3x2fvP(dst Uniform, count int32, transpose bool, value *float32) {
+	UniformMatrix3x2fv(dst, transpose, getFloat32Slice(int(count*6), unsafe.Pointer(value)))
+}
+
+func UniformMatrix3x2fvUP(dst Uniform, count int32, transpose bool, value unsafe.Pointer) {
+	UniformMatrix3x2fv(dst, transpose, getFloat32Slice(int(count*6), value))
+}
+
+// UniformMatrix3x4fv writes 3x4 matrices. Each matrix uses 12
+// float32 values, so the number of matrices written is len(src)/12.
+//
+// Each matrix must be supplied in column major order.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func UniformMatrix3x4fv(dst Uniform, transpose bool, src []float32) {
+	if ctx3, ok := _pluginInstance.glContext.(glContext3); ok {
+		ctx3.UniformMatrix3x4fv(gl.Uniform{int32(dst)}, src)
+	} else {
+		fmt.Printf("WARNING: UniformMatrix3x4fv not implemented\n")
+	}
+}
+
+func UniformMatrix3x4fvP(dst Uniform, count int32, transpose bool, value *float32) {
+	UniformMatrix3x4fv(dst, transpose, getFloat32Slice(int(count*12), unsafe.Pointer(value)))
+}
+
+func UniformMatrix3x4fvUP(dst Uniform, count int32, transpose bool, value unsafe.Pointer) {
+	UniformMatrix3x4fv(dst, transpose, getFloat32Slice(int(count*12), value))
+}
+
 // UniformMatrix4fv writes 4x4 matrices. Each matrix uses 16
 // float32 values, so the number of matrices written is len(src)/16.
 //
@@ -1164,6 +1946,59 @@ func UniformMatrix4fvUP(dst Uniform, count int32, transpose bool, value unsafe.P
 	_pluginInstance.glContext.UniformMatrix4fvUP(gl.Uniform{int32(dst)}, count, value)
 }
 
+// UniformMatrix4x2fv writes 4x2 matrices. Each matrix uses eight
+// float32 values, so the number of matrices written is len(src)/8.
+//
+// Each matrix must be supplied in column major order.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func UniformMatrix4x2fv(dst Uniform, transpose bool, src []float32) {
+	if ctx3, ok := _pluginInstance.glContext.(glContext3); ok {
+		ctx3.UniformMatrix4x2fv(gl.Uniform{int32(dst)}, src)
+	} else {
+		fmt.Printf("WARNING: UniformMatrix4x2fv not implemented\n")
+	}
+}
+
+func UniformMatrix4x2fvP(dst Uniform, count int32, transpose bool, value *float32) {
+	UniformMatrix4x2fv(dst, transpose, getFloat32Slice(int(count*8), unsafe.Pointer(value)))
+}
+
+func UniformMatrix4x2fvUP(dst Uniform, count int32, transpose bool, value unsafe.Pointer) {
+	UniformMatrix4x2fv(dst, transpose, getFloat32Slice(int(count*8), value))
+}
+
+// UniformMatrix4x3fv writes 4x3 matrices. Each matrix uses 12
+// float32 values, so the number of matrices written is len(src)/12.
+//
+// Each matrix must be supplied in column major order.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUniform.xhtml
+func UniformMatrix4x3fv(dst Uniform, transpose bool, src []float32) {
+	if ctx3, ok := _pluginInstance.glContext.(glContext3); ok {
+		ctx3.UniformMatrix4x3fv(gl.Uniform{int32(dst)}, src)
+	} else {
+		fmt.Printf("WARNING: UniformMatrix4x3fv not implemented\n")
+	}
+}
+
+func UniformMatrix4x3fvP(dst Uniform, count int32, transpose bool, value *float32) {
+	UniformMatrix4x3fv(dst, transpose, getFloat32Slice(int(count*12), unsafe.Pointer(value)))
+}
+
+func UniformMatrix4x3fvUP(dst Uniform, count int32, transpose bool, value unsafe.Pointer) {
+	UniformMatrix4x3fv(dst, transpose, getFloat32Slice(int(count*12), value))
+}
+
+// UnmapBuffer releases the mapping of the bound buffer object, it returns
+// false if the content of the buffer has been corrupted while mapped.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glUnmapBuffer.xhtml
+func UnmapBuffer(target Enum) bool {
+	fmt.Printf("WARNING: UnmapBuffer not implemented\n")
+	return false
+}
+
 // UseProgram sets the active program.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glUseProgram.xhtml
@@ -1237,6 +2072,36 @@ func VertexAttrib4fv(dst Attrib, src []float32) {
 	_pluginInstance.glContext.VertexAttrib4fv(gl.Attrib{uint(int32(dst))}, src)
 }
 
+// VertexAttribDivisor modifies the rate at which generic vertex attributes
+// advance during instanced rendering.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glVertexAttribDivisor.xhtml
+func VertexAttribDivisor(index Attrib, divisor int) {
+	fmt.Printf("WARNING: VertexAttribDivisor not implemented\n")
+}
+
+// VertexAttribI4i writes an ivec4 vertex attribute.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glVertexAttrib.xhtml
+func VertexAttribI4i(dst Attrib, x, y, z, w int32) {
+	fmt.Printf("WARNING: VertexAttribI4i not implemented\n")
+}
+
+// VertexAttribI4ui writes an uvec4 vertex attribute.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glVertexAttrib.xhtml
+func VertexAttribI4ui(dst Attrib, x, y, z, w uint32) {
+	fmt.Printf("WARNING: VertexAttribI4ui not implemented\n")
+}
+
+// VertexAttribIPointer uses a bound buffer to define integer vertex attribute data,
+// values are fed to the shader as integers without conversion to float.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glVertexAttribPointer.xhtml
+func VertexAttribIPointer(dst Attrib, size int, ty Enum, stride, offset int) {
+	fmt.Printf("WARNING: VertexAttribIPointer not implemented\n")
+}
+
 // VertexAttribPointer uses a bound buffer to define vertex attribute data.
 //
 // http://www.khronos.org/opengles/sdk/docs/man3/html/glVertexAttribPointer.xhtml
@@ -1244,6 +2109,14 @@ func VertexAttribPointer(dst Attrib, size int, ty Enum, normalized bool, stride,
 	_pluginInstance.glContext.VertexAttribPointer(gl.Attrib{uint(int32(dst))}, size, gl.Enum(ty), normalized, stride, offset)
 }
 
+// WaitSync instructs the GL server to wait until the sync object s
+// is signaled, timeout must be TIMEOUT_IGNORED.
+//
+// http://www.khronos.org/opengles/sdk/docs/man3/html/glWaitSync.xhtml
+func WaitSync(s Sync, flags Enum, timeout uint64) {
+	fmt.Printf("WARNING: WaitSync not implemented\n")
+}
+
 // Viewport sets the viewport, an affine transformation that
 // normalizes device coordinates to window coordinates.
 //
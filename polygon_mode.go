@@ -0,0 +1,122 @@
+// Copyright (c) 2019 Thomas MILLET. All rights reserved.
+
+// +build android ios js
+
+package gl
+
+import (
+	fmt "fmt"
+)
+
+// GLES3 and WebGL2 have no glPolygonMode, the LINE mode is emulated by drawing
+// the edges of triangles primitives with LINES from a generated index buffer.
+// Indices of the bound element array are read back by readElementArray of each
+// backend, the draw is skipped if they cannot be read.
+
+// Current polygon mode, only LINE and FILL are supported
+var polygonMode = Enum(FILL)
+
+// Index buffer used to draw the edges in LINE mode, created on first use
+var polygonModeBuffer = Buffer(NONE)
+
+// setPolygonMode switches between LINE and FILL modes
+func setPolygonMode(mode Enum) {
+	switch mode {
+	case LINE, FILL:
+		polygonMode = mode
+	default:
+		fmt.Printf("WARNING: PolygonMode %d not implemented\n", mode)
+	}
+}
+
+// flushPolygonMode releases the emulation resources
+func flushPolygonMode() {
+	if polygonModeBuffer.Valid() {
+		DeleteBuffer(polygonModeBuffer)
+	}
+	polygonModeBuffer = NONE
+}
+
+// isPolygonModeLine indicates if primitives of given mode must be drawn as edges
+func isPolygonModeLine(mode Enum) bool {
+	return polygonMode == LINE && (mode == TRIANGLES || mode == TRIANGLE_STRIP || mode == TRIANGLE_FAN)
+}
+
+// drawArraysAsLines draws the edges of triangles primitives from the bound arrays
+func drawArraysAsLines(mode Enum, first, count int) {
+	indices := make([]uint32, count)
+	for i := range indices {
+		indices[i] = uint32(first + i)
+	}
+	drawEdges(mode, indices)
+}
+
+// drawElementsAsLines draws the edges of triangles primitives from the bound element array
+func drawElementsAsLines(mode Enum, count int, ty Enum, offset int) {
+	size := 0
+	switch ty {
+	case UNSIGNED_BYTE:
+		size = 1
+	case UNSIGNED_SHORT:
+		size = 2
+	case UNSIGNED_INT:
+		size = 4
+	}
+	var data []byte
+	if size > 0 {
+		data = readElementArray(offset, size*count)
+	}
+	if data == nil {
+		fmt.Printf("WARNING: PolygonMode LINE failed to read element array\n")
+		return
+	}
+
+	indices := make([]uint32, count)
+	for i := range indices {
+		switch size {
+		case 1:
+			indices[i] = uint32(data[i])
+		case 2:
+			indices[i] = uint32(nativeEndian.Uint16(data[2*i:]))
+		case 4:
+			indices[i] = nativeEndian.Uint32(data[4*i:])
+		}
+	}
+	drawEdges(mode, indices)
+}
+
+// drawEdges draws with LINES the edges of the triangles defined by mode and indices
+func drawEdges(mode Enum, indices []uint32) {
+	edges := make([]uint32, 0, 6*len(indices))
+	switch mode {
+	case TRIANGLES:
+		for i := 0; i+2 < len(indices); i += 3 {
+			edges = append(edges, indices[i], indices[i+1], indices[i+1], indices[i+2], indices[i+2], indices[i])
+		}
+	case TRIANGLE_STRIP:
+		for i := 0; i+2 < len(indices); i++ {
+			edges = append(edges, indices[i], indices[i+1], indices[i+1], indices[i+2], indices[i+2], indices[i])
+		}
+	case TRIANGLE_FAN:
+		for i := 1; i+1 < len(indices); i++ {
+			edges = append(edges, indices[0], indices[i], indices[i], indices[i+1], indices[i+1], indices[0])
+		}
+	}
+	if len(edges) == 0 {
+		return
+	}
+
+	data := getByteArrayBuffer(4 * len(edges))
+	for i, e := range edges {
+		nativeEndian.PutUint32(data[4*i:], e)
+	}
+
+	previous := Buffer(GetInteger(ELEMENT_ARRAY_BUFFER_BINDING))
+	if !polygonModeBuffer.Valid() {
+		polygonModeBuffer = CreateBuffer()
+	}
+	BindBuffer(ELEMENT_ARRAY_BUFFER, polygonModeBuffer)
+	BufferData(ELEMENT_ARRAY_BUFFER, data, STREAM_DRAW)
+	DrawElements(LINES, len(edges), UNSIGNED_INT, 0)
+	BindBuffer(ELEMENT_ARRAY_BUFFER, previous)
+}
@@ -0,0 +1,146 @@
+// Copyright (c) 2019 Thomas MILLET. All rights reserved.
+
+package gl
+
+import (
+	fmt "fmt"
+	regexp "regexp"
+	strconv "strconv"
+	strings "strings"
+)
+
+// Program build stages reported in ProgramError
+const (
+	StageVertex   = "vertex"
+	StageFragment = "fragment"
+	StageLink     = "link"
+)
+
+// Diagnostic is a single message parsed from a shader or program info log
+type Diagnostic struct {
+	// File is the source string index reported by the driver, usually "0"
+	File string
+	// Line is the line number in the source, 0 if unknown
+	Line int
+	// Severity is either "error" or "warning"
+	Severity string
+	// Message is the driver message without its location prefix
+	Message string
+}
+
+// String implements fmt.Stringer
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s:%d: %s: %s", d.File, d.Line, d.Severity, d.Message)
+}
+
+// ProgramError is returned by BuildProgram when a shader fails to compile or
+// the program fails to link
+type ProgramError struct {
+	// Stage is one of StageVertex, StageFragment or StageLink
+	Stage string
+	// Log is the raw info log returned by the driver
+	Log string
+	// Diagnostics are the messages parsed from Log
+	Diagnostics []Diagnostic
+}
+
+// Error implements error
+func (e *ProgramError) Error() string {
+	if e.Stage == StageLink {
+		return fmt.Sprintf("Failed to link program: %s", strings.TrimSpace(e.Log))
+	}
+	return fmt.Sprintf("Failed to compile %s shader: %s", e.Stage, strings.TrimSpace(e.Log))
+}
+
+// BuildProgram compiles the vertex and fragment shaders sources and links them
+// into a new program, a *ProgramError is returned on failure.
+func BuildProgram(vertexSrc, fragmentSrc string) (Program, error) {
+	return buildProgram(vertexSrc, fragmentSrc, false)
+}
+
+// buildProgram implements BuildProgram, retrievable sets PROGRAM_BINARY_RETRIEVABLE_HINT
+// before linking for the binary to be stored by ProgramCache
+func buildProgram(vertexSrc, fragmentSrc string, retrievable bool) (Program, error) {
+	vertexShader, err := buildShader(VERTEX_SHADER, StageVertex, vertexSrc)
+	if err != nil {
+		return NONE, err
+	}
+	defer DeleteShader(vertexShader)
+
+	fragmentShader, err := buildShader(FRAGMENT_SHADER, StageFragment, fragmentSrc)
+	if err != nil {
+		return NONE, err
+	}
+	defer DeleteShader(fragmentShader)
+
+	p := CreateProgram()
+	AttachShader(p, vertexShader)
+	AttachShader(p, fragmentShader)
+	if retrievable {
+		ProgramParameteri(p, PROGRAM_BINARY_RETRIEVABLE_HINT, TRUE)
+	}
+	LinkProgram(p)
+	if GetProgrami(p, LINK_STATUS) == FALSE {
+		log := GetProgramInfoLog(p)
+		DeleteProgram(p)
+		return NONE, &ProgramError{Stage: StageLink, Log: log, Diagnostics: ParseInfoLog(log)}
+	}
+	return p, nil
+}
+
+// buildShader compiles a shader of type ty from its source
+func buildShader(ty Enum, stage string, src string) (Shader, error) {
+	s := CreateShader(ty)
+	ShaderSource(s, src)
+	CompileShader(s)
+	if GetShaderi(s, COMPILE_STATUS) == FALSE {
+		log := GetShaderInfoLog(s)
+		DeleteShader(s)
+		return NONE, &ProgramError{Stage: stage, Log: log, Diagnostics: ParseInfoLog(log)}
+	}
+	return s, nil
+}
+
+// Info log formats of the main drivers, submatches are named file, line,
+// severity and message
+var infoLogPatterns = []*regexp.Regexp{
+	// Mesa: 0:12(5): error: `foo' undeclared
+	regexp.MustCompile(`^\s*(?P<file>\d+):(?P<line>\d+)\(\d+\)\s*:\s*(?P<severity>\w+)\s*:\s*(?P<message>.*)$`),
+	// NVIDIA: 0(12) : error C1008: undefined variable "foo"
+	regexp.MustCompile(`^\s*(?P<file>\d+)\((?P<line>\d+)\)\s*:\s*(?P<severity>\w+)\s*(?:[A-Z]\d+\s*)?:\s*(?P<message>.*)$`),
+	// ANGLE, Apple, Adreno, PowerVR: ERROR: 0:12: 'foo' : undeclared identifier
+	regexp.MustCompile(`^\s*(?P<severity>\w+)\s*:\s*(?P<file>\d+):(?P<line>\d+)\s*:\s*(?P<message>.*)$`),
+	// Mali: 0:12: L0002: Undeclared variable 'foo'
+	regexp.MustCompile(`^\s*(?P<file>\d+):(?P<line>\d+)\s*:\s*(?P<message>.*)$`),
+}
+
+// ParseInfoLog extracts the located messages of a shader or program info log,
+// lines which cannot be located (summaries, empty lines) are ignored.
+func ParseInfoLog(log string) []Diagnostic {
+	var diagnostics []Diagnostic
+	for _, line := range strings.Split(log, "\n") {
+		line = strings.TrimRight(line, "\r\x00")
+		for _, pattern := range infoLogPatterns {
+			match := pattern.FindStringSubmatch(line)
+			if match == nil {
+				continue
+			}
+			d := Diagnostic{Severity: "error"}
+			for i, name := range pattern.SubexpNames() {
+				switch name {
+				case "file":
+					d.File = match[i]
+				case "line":
+					d.Line, _ = strconv.Atoi(match[i])
+				case "severity":
+					d.Severity = strings.ToLower(match[i])
+				case "message":
+					d.Message = strings.TrimSpace(match[i])
+				}
+			}
+			diagnostics = append(diagnostics, d)
+			break
+		}
+	}
+	return diagnostics
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2019 Thomas MILLET. All rights reserved.
+
+package gl
+
+import (
+	sha256 "crypto/sha256"
+	binary "encoding/binary"
+	hex "encoding/hex"
+	ioutil "io/ioutil"
+	os "os"
+	filepath "path/filepath"
+	regexp "regexp"
+	strconv "strconv"
+)
+
+// ProgramCache stores linked programs binaries in a directory to avoid
+// compiling and linking shaders at each startup. Entries are keyed by a hash
+// of the shaders sources and of the RENDERER and VERSION strings of the driver.
+type ProgramCache struct {
+	dir     string
+	enabled bool
+}
+
+// NewProgramCache creates a ProgramCache storing its entries in dir, the
+// directory is created if needed. If the current context cannot retrieve
+// program binaries, the returned cache is disabled and always compiles programs
+// from sources.
+func NewProgramCache(dir string) (*ProgramCache, error) {
+	c := &ProgramCache{dir: dir}
+	// NUM_PROGRAM_BINARY_FORMATS is only a valid query from OpenGL 4.1
+	if !programBinarySupported || !glVersionAtLeast(4, 1) || GetInteger(NUM_PROGRAM_BINARY_FORMATS) == 0 {
+		return c, nil
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	c.enabled = true
+	return c, nil
+}
+
+var glVersionPattern = regexp.MustCompile(`(\d+)\.(\d+)`)
+
+// glVersionAtLeast indicates if the VERSION of the current context is at least major.minor
+func glVersionAtLeast(major, minor int) bool {
+	match := glVersionPattern.FindStringSubmatch(GetString(VERSION))
+	if match == nil {
+		return false
+	}
+	contextMajor, _ := strconv.Atoi(match[1])
+	contextMinor, _ := strconv.Atoi(match[2])
+	return contextMajor > major || (contextMajor == major && contextMinor >= minor)
+}
+
+// Enabled indicates if programs binaries are actually stored by the cache
+func (c *ProgramCache) Enabled() bool {
+	return c.enabled
+}
+
+// Program returns the program linked from vertexSrc and fragmentSrc, the binary
+// stored in cache is used if available and accepted by the driver, the program
+// is compiled from sources otherwise and its binary stored in cache.
+func (c *ProgramCache) Program(vertexSrc, fragmentSrc string) (Program, error) {
+	if !c.enabled {
+		return BuildProgram(vertexSrc, fragmentSrc)
+	}
+
+	path := filepath.Join(c.dir, c.key(vertexSrc, fragmentSrc))
+	if entry, err := ioutil.ReadFile(path); err == nil && len(entry) > 4 {
+		p := CreateProgram()
+		ProgramBinary(p, Enum(binary.LittleEndian.Uint32(entry)), entry[4:])
+		if GetProgrami(p, LINK_STATUS) == TRUE {
+			return p, nil
+		}
+		DeleteProgram(p)
+		os.Remove(path)
+	}
+
+	p, err := buildProgram(vertexSrc, fragmentSrc, true)
+	if err != nil {
+		return p, err
+	}
+
+	if data, format := GetProgramBinary(p); len(data) > 0 {
+		entry := make([]byte, 4+len(data))
+		binary.LittleEndian.PutUint32(entry, uint32(format))
+		copy(entry[4:], data)
+		// A failed write only means a cache miss at next startup
+		ioutil.WriteFile(path, entry, 0644)
+	}
+	return p, nil
+}
+
+// key computes the cache entry name of a program from its sources and the current driver
+func (c *ProgramCache) key(vertexSrc, fragmentSrc string) string {
+	h := sha256.New()
+	for _, s := range []string{vertexSrc, fragmentSrc, GetString(RENDERER), GetString(VERSION)} {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
@@ -0,0 +1,22 @@
+// Copyright (c) 2019 Thomas MILLET. All rights reserved.
+package gl
+
+import (
+	"testing"
+)
+
+func TestParseInfoLog(t *testing.T) {
+	logs := map[string]Diagnostic{
+		"0:12(5): error: `foo' undeclared":                {"0", 12, "error", "`foo' undeclared"},
+		"0(12) : error C1008: undefined variable \"foo\"": {"0", 12, "error", "undefined variable \"foo\""},
+		"ERROR: 0:12: 'foo' : undeclared identifier":      {"0", 12, "error", "'foo' : undeclared identifier"},
+		"WARNING: 0:3: extension not supported":           {"0", 3, "warning", "extension not supported"},
+		"0:12: L0002: Undeclared variable 'foo'":          {"0", 12, "error", "L0002: Undeclared variable 'foo'"},
+	}
+	for log, expected := range logs {
+		diagnostics := ParseInfoLog(log + "\nERROR: 1 compilation errors.  No code generated.\n\x00")
+		if len(diagnostics) != 1 || diagnostics[0] != expected {
+			t.Errorf("ParseInfoLog(%q) = %v, expected %v", log, diagnostics, expected)
+		}
+	}
+}
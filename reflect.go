@@ -0,0 +1,164 @@
+// Copyright (c) 2019 Thomas MILLET. All rights reserved.
+
+package gl
+
+import (
+	strings "strings"
+)
+
+// AttribInfo describes an active attribute of a program
+type AttribInfo struct {
+	// Name is the attribute name, without the [0] suffix of arrays
+	Name string
+	// Location is the attribute location
+	Location Attrib
+	// Type is the attribute type (FLOAT_VEC3, FLOAT_MAT4...)
+	Type Enum
+	// TypeName is the GLSL name of Type (vec3, mat4...)
+	TypeName string
+	// Size is the number of elements of arrays, 1 otherwise
+	Size int
+}
+
+// UniformInfo describes an active uniform of a program
+type UniformInfo struct {
+	// Name is the uniform name, without the [0] suffix of arrays
+	Name string
+	// Location is the uniform location, invalid for uniforms declared in blocks
+	Location Uniform
+	// Type is the uniform type (FLOAT_VEC3, SAMPLER_2D...)
+	Type Enum
+	// TypeName is the GLSL name of Type (vec3, sampler2D...)
+	TypeName string
+	// Size is the number of elements of arrays, 1 otherwise
+	Size int
+	// Block is the index of the uniform block declaring the uniform, -1 for
+	// uniforms of the default block
+	Block int
+}
+
+// ProgramInfo lists the active attributes and uniforms of a program
+type ProgramInfo struct {
+	Attributes []AttribInfo
+	Uniforms   []UniformInfo
+}
+
+// Attribute returns the description of the active attribute name
+func (i *ProgramInfo) Attribute(name string) (AttribInfo, bool) {
+	for _, a := range i.Attributes {
+		if a.Name == name {
+			return a, true
+		}
+	}
+	return AttribInfo{}, false
+}
+
+// Uniform returns the description of the active uniform name
+func (i *ProgramInfo) Uniform(name string) (UniformInfo, bool) {
+	for _, u := range i.Uniforms {
+		if u.Name == name {
+			return u, true
+		}
+	}
+	return UniformInfo{}, false
+}
+
+// GLSL names of the attributes and uniforms types
+var glslTypeNames = map[Enum]string{
+	FLOAT:                         "float",
+	FLOAT_VEC2:                    "vec2",
+	FLOAT_VEC3:                    "vec3",
+	FLOAT_VEC4:                    "vec4",
+	INT:                           "int",
+	INT_VEC2:                      "ivec2",
+	INT_VEC3:                      "ivec3",
+	INT_VEC4:                      "ivec4",
+	UNSIGNED_INT:                  "uint",
+	UNSIGNED_INT_VEC2:             "uvec2",
+	UNSIGNED_INT_VEC3:             "uvec3",
+	UNSIGNED_INT_VEC4:             "uvec4",
+	BOOL:                          "bool",
+	BOOL_VEC2:                     "bvec2",
+	BOOL_VEC3:                     "bvec3",
+	BOOL_VEC4:                     "bvec4",
+	FLOAT_MAT2:                    "mat2",
+	FLOAT_MAT3:                    "mat3",
+	FLOAT_MAT4:                    "mat4",
+	FLOAT_MAT2x3:                  "mat2x3",
+	FLOAT_MAT2x4:                  "mat2x4",
+	FLOAT_MAT3x2:                  "mat3x2",
+	FLOAT_MAT3x4:                  "mat3x4",
+	FLOAT_MAT4x2:                  "mat4x2",
+	FLOAT_MAT4x3:                  "mat4x3",
+	SAMPLER_2D:                    "sampler2D",
+	SAMPLER_3D:                    "sampler3D",
+	SAMPLER_CUBE:                  "samplerCube",
+	SAMPLER_2D_SHADOW:             "sampler2DShadow",
+	SAMPLER_2D_ARRAY:              "sampler2DArray",
+	SAMPLER_2D_ARRAY_SHADOW:       "sampler2DArrayShadow",
+	SAMPLER_CUBE_SHADOW:           "samplerCubeShadow",
+	INT_SAMPLER_2D:                "isampler2D",
+	INT_SAMPLER_3D:                "isampler3D",
+	INT_SAMPLER_CUBE:              "isamplerCube",
+	INT_SAMPLER_2D_ARRAY:          "isampler2DArray",
+	UNSIGNED_INT_SAMPLER_2D:       "usampler2D",
+	UNSIGNED_INT_SAMPLER_3D:       "usampler3D",
+	UNSIGNED_INT_SAMPLER_CUBE:     "usamplerCube",
+	UNSIGNED_INT_SAMPLER_2D_ARRAY: "usampler2DArray",
+}
+
+// GLSLTypeName returns the GLSL name of an attribute or uniform type, an empty
+// string if ty is not a GLSL type
+func GLSLTypeName(ty Enum) string {
+	return glslTypeNames[ty]
+}
+
+// Reflect lists the active attributes and uniforms of the linked program p
+func Reflect(p Program) ProgramInfo {
+	var info ProgramInfo
+
+	attributesCount := GetProgrami(p, ACTIVE_ATTRIBUTES)
+	for i := 0; i < attributesCount; i++ {
+		name, size, ty := GetActiveAttrib(p, uint32(i))
+		info.Attributes = append(info.Attributes, AttribInfo{
+			Name:     strings.TrimSuffix(name, "[0]"),
+			Location: GetAttribLocation(p, name),
+			Type:     ty,
+			TypeName: GLSLTypeName(ty),
+			Size:     size,
+		})
+	}
+
+	uniformsCount := GetProgrami(p, ACTIVE_UNIFORMS)
+	if uniformsCount == 0 {
+		return info
+	}
+	blocks := make([]int32, uniformsCount)
+	if uniformBlockSupported {
+		indices := make([]uint32, uniformsCount)
+		for i := range indices {
+			indices[i] = uint32(i)
+		}
+		GetActiveUniformsiv(blocks, p, indices, UNIFORM_BLOCK_INDEX)
+	} else {
+		for i := range blocks {
+			blocks[i] = -1
+		}
+	}
+	for i := 0; i < uniformsCount; i++ {
+		name, size, ty := GetActiveUniform(p, uint32(i))
+		location := Uniform(-1)
+		if blocks[i] < 0 {
+			location = GetUniformLocation(p, name)
+		}
+		info.Uniforms = append(info.Uniforms, UniformInfo{
+			Name:     strings.TrimSuffix(name, "[0]"),
+			Location: location,
+			Type:     ty,
+			TypeName: GLSLTypeName(ty),
+			Size:     size,
+			Block:    int(blocks[i]),
+		})
+	}
+	return info
+}
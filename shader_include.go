@@ -0,0 +1,208 @@
+// Copyright (c) 2019 Thomas MILLET. All rights reserved.
+
+package gl
+
+import (
+	fmt "fmt"
+	ioutil "io/ioutil"
+	path "path"
+	filepath "path/filepath"
+	regexp "regexp"
+	sort "sort"
+	strings "strings"
+)
+
+// ShaderSourceProvider reads shaders sources from slash separated names, it is
+// implemented by ShaderSourceMap, ShaderSourceDir and embedded filesystems
+// exposing a ReadFile method
+type ShaderSourceProvider interface {
+	ReadFile(name string) ([]byte, error)
+}
+
+// ShaderSourceMap is an in-memory ShaderSourceProvider mapping names to sources
+type ShaderSourceMap map[string]string
+
+// ReadFile implements ShaderSourceProvider
+func (m ShaderSourceMap) ReadFile(name string) ([]byte, error) {
+	if src, found := m[name]; found {
+		return []byte(src), nil
+	}
+	return nil, fmt.Errorf("Shader source not found: %s", name)
+}
+
+// ShaderSourceDir is a ShaderSourceProvider reading sources from a directory
+type ShaderSourceDir string
+
+// ReadFile implements ShaderSourceProvider
+func (d ShaderSourceDir) ReadFile(name string) ([]byte, error) {
+	return ioutil.ReadFile(filepath.Join(string(d), filepath.FromSlash(name)))
+}
+
+// ShaderLocation is a line in an original shader source file
+type ShaderLocation struct {
+	File string
+	Line int
+}
+
+// ExpandedShader is a shader source with its includes resolved
+type ExpandedShader struct {
+	// Source is the expanded source to give to ShaderSource
+	Source string
+	// Lines maps each line of Source (Lines[0] is line 1) to its origin,
+	// injected #define lines have a 0 Line
+	Lines []ShaderLocation
+}
+
+// Locate returns the original location of a line of the expanded source
+func (s *ExpandedShader) Locate(line int) (ShaderLocation, bool) {
+	if line < 1 || line > len(s.Lines) {
+		return ShaderLocation{}, false
+	}
+	return s.Lines[line-1], true
+}
+
+// MapDiagnostics rewrites the File and Line of diagnostics reported on the
+// expanded source to their original locations
+func (s *ExpandedShader) MapDiagnostics(diagnostics []Diagnostic) []Diagnostic {
+	mapped := make([]Diagnostic, len(diagnostics))
+	for i, d := range diagnostics {
+		if location, found := s.Locate(d.Line); found {
+			d.File, d.Line = location.File, location.Line
+		}
+		mapped[i] = d
+	}
+	return mapped
+}
+
+// ShaderIncluder resolves the #include "file" directives of shaders sources.
+// Included names are relative to the including file and each file can be
+// included several times, include guards being left to the sources.
+type ShaderIncluder struct {
+	provider ShaderSourceProvider
+}
+
+// NewShaderIncluder creates a ShaderIncluder reading sources from provider
+func NewShaderIncluder(provider ShaderSourceProvider) *ShaderIncluder {
+	return &ShaderIncluder{provider: provider}
+}
+
+var shaderIncludePattern = regexp.MustCompile(`^[ \t]*#[ \t]*include[ \t]+"([^"]+)"[ \t]*$`)
+
+// Expand reads the shader source name and resolves its includes recursively.
+// The defines are injected as #define directives at the top of the source,
+// after its #version line if any.
+func (i *ShaderIncluder) Expand(name string, defines map[string]string) (*ExpandedShader, error) {
+	src, err := i.provider.ReadFile(name)
+	if err != nil {
+		return nil, err
+	}
+	lines := strings.Split(strings.Replace(string(src), "\r\n", "\n", -1), "\n")
+
+	s := &ExpandedShader{}
+	var out []string
+	start := 0
+	if version := shaderVersionLine(lines); version >= 0 {
+		for n := 0; n <= version; n++ {
+			out = append(out, lines[n])
+			s.Lines = append(s.Lines, ShaderLocation{name, n + 1})
+		}
+		start = version + 1
+	}
+
+	// Sorted to get the same source, and cache key, for the same defines
+	keys := make([]string, 0, len(defines))
+	for k := range defines {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		out = append(out, strings.TrimSpace("#define "+k+" "+defines[k]))
+		s.Lines = append(s.Lines, ShaderLocation{name, 0})
+	}
+
+	if out, err = i.expand(s, out, name, lines, start, []string{name}); err != nil {
+		return nil, err
+	}
+	s.Source = strings.Join(out, "\n")
+	return s, nil
+}
+
+// shaderVersionLine returns the index of the #version line if it only follows
+// comments and blank lines, -1 otherwise
+func shaderVersionLine(lines []string) int {
+	inComment := false
+	for n, line := range lines {
+		for line = strings.TrimSpace(line); line != ""; line = strings.TrimSpace(line) {
+			if inComment {
+				end := strings.Index(line, "*/")
+				if end < 0 {
+					break
+				}
+				line, inComment = line[end+2:], false
+			} else if strings.HasPrefix(line, "/*") {
+				line, inComment = line[2:], true
+			} else if strings.HasPrefix(line, "//") {
+				break
+			} else if shaderVersionPattern.MatchString(line) {
+				return n
+			} else {
+				return -1
+			}
+		}
+	}
+	return -1
+}
+
+// expand appends lines of file name from start to out, stack holds the files
+// being expanded to detect cycles
+func (i *ShaderIncluder) expand(s *ExpandedShader, out []string, name string, lines []string, start int, stack []string) ([]string, error) {
+	for n := start; n < len(lines); n++ {
+		match := shaderIncludePattern.FindStringSubmatch(lines[n])
+		if match == nil {
+			out = append(out, lines[n])
+			s.Lines = append(s.Lines, ShaderLocation{name, n + 1})
+			continue
+		}
+
+		include := path.Join(path.Dir(name), match[1])
+		for _, parent := range stack {
+			if parent == include {
+				return nil, fmt.Errorf("Include cycle at %s:%d: %s", name, n+1, strings.Join(append(stack, include), " -> "))
+			}
+		}
+		src, err := i.provider.ReadFile(include)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to include %s at %s:%d: %s", include, name, n+1, err)
+		}
+		includeLines := strings.Split(strings.TrimSuffix(strings.Replace(string(src), "\r\n", "\n", -1), "\n"), "\n")
+		if out, err = i.expand(s, out, include, includeLines, 0, append(stack, include)); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// BuildProgram expands the vertex and fragment shaders sources, adapts them to
+// the current target with PreprocessShader and builds the program. Diagnostics
+// of a returned *ProgramError point to the original files and lines.
+func (i *ShaderIncluder) BuildProgram(vertexName, fragmentName string, defines map[string]string) (Program, error) {
+	vertexShader, err := i.Expand(vertexName, defines)
+	if err != nil {
+		return NONE, err
+	}
+	fragmentShader, err := i.Expand(fragmentName, defines)
+	if err != nil {
+		return NONE, err
+	}
+
+	p, err := BuildProgram(PreprocessShader(VERTEX_SHADER, vertexShader.Source), PreprocessShader(FRAGMENT_SHADER, fragmentShader.Source))
+	if programErr, ok := err.(*ProgramError); ok {
+		switch programErr.Stage {
+		case StageVertex:
+			programErr.Diagnostics = vertexShader.MapDiagnostics(programErr.Diagnostics)
+		case StageFragment:
+			programErr.Diagnostics = fragmentShader.MapDiagnostics(programErr.Diagnostics)
+		}
+	}
+	return p, err
+}
@@ -0,0 +1,42 @@
+// Copyright (c) 2019 Thomas MILLET. All rights reserved.
+package gl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestShaderIncluderExpand(t *testing.T) {
+	includer := NewShaderIncluder(ShaderSourceMap{
+		"main.frag":      "#version 300 es\n#include \"lib/light.glsl\"\nvoid main() {}\n",
+		"lib/light.glsl": "#include \"fog.glsl\"\nvec3 light;\n",
+		"lib/fog.glsl":   "vec3 fog;\n",
+		"license.frag":   "/* License\n * text */\n// Comment\n\n#version 300 es\nvoid main() {}\n",
+		"cycle.frag":     "#include \"cycle.glsl\"\n",
+		"cycle.glsl":     "#include \"cycle.frag\"\n",
+	})
+
+	s, err := includer.Expand("main.frag", map[string]string{"LIGHTS": "4", "FOG": ""})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "#version 300 es\n#define FOG\n#define LIGHTS 4\nvec3 fog;\nvec3 light;\nvoid main() {}\n"
+	if s.Source != expected {
+		t.Errorf("Expand() = %q, expected %q", s.Source, expected)
+	}
+	if location, _ := s.Locate(5); location != (ShaderLocation{"lib/light.glsl", 2}) {
+		t.Errorf("Locate(5) = %v, expected lib/light.glsl:2", location)
+	}
+
+	s, err = includer.Expand("license.frag", map[string]string{"FOG": ""})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(s.Source, "#version 300 es\n#define FOG\n") {
+		t.Errorf("Expand() = %q, expected #define after #version", s.Source)
+	}
+
+	if _, err := includer.Expand("cycle.frag", nil); err == nil {
+		t.Errorf("Expand() should detect include cycle")
+	}
+}
@@ -0,0 +1,128 @@
+// Copyright (c) 2019 Thomas MILLET. All rights reserved.
+
+package gl
+
+import (
+	regexp "regexp"
+	strings "strings"
+)
+
+// Default precisions injected in ES shaders, sampler2D and samplerCube have a
+// lowp default precision and other samplers have none in GLSL ES 3.00
+var shaderESPrecisions = []string{
+	"highp float",
+	"highp int",
+	"mediump sampler2D",
+	"mediump samplerCube",
+	"mediump sampler3D",
+	"mediump sampler2DShadow",
+	"mediump samplerCubeShadow",
+	"mediump sampler2DArray",
+	"mediump sampler2DArrayShadow",
+	"mediump isampler2D",
+	"mediump isampler3D",
+	"mediump isamplerCube",
+	"mediump isampler2DArray",
+	"mediump usampler2D",
+	"mediump usampler3D",
+	"mediump usamplerCube",
+	"mediump usampler2DArray",
+}
+
+// Name of the output declared in fragment shaders using gl_FragColor
+const shaderFragColorOutput = "tge_FragColor"
+
+var shaderVersionPattern = regexp.MustCompile(`(?m)^[ \t]*#[ \t]*version\b.*$`)
+var shaderDirectivePattern = regexp.MustCompile(`^[ \t]*#[ \t]*(\w+)`)
+var shaderFragColorPattern = regexp.MustCompile(`\bgl_FragColor\b`)
+
+// PreprocessShader adapts a shader source of type ty (VERTEX_SHADER or
+// FRAGMENT_SHADER) to the GLSL dialect of the current target:
+//  - any #version line is replaced by the one returned by GetGLSLVersion()
+//  - #extension lines outside #if blocks are moved right after #version as
+//    GLSL ES requires them before any declaration
+//  - default precisions of floats, integers and all samplers are set on ES
+//  - gl_FragColor is replaced by a declared output in fragment shaders, except
+//    in comments
+// A #line directive follows the injected header so that line numbers in info
+// logs still refer to the original source.
+func PreprocessShader(ty Enum, src string) string {
+	return preprocessShader(ty, src, GetGLSLVersion())
+}
+
+// preprocessShader implements PreprocessShader for the given GLSL version
+func preprocessShader(ty Enum, src string, version string) string {
+	// Keep the line of an existing #version to preserve lines numbering
+	src = shaderVersionPattern.ReplaceAllString(src, "")
+
+	var header strings.Builder
+	header.WriteString("#version " + version + "\n")
+	lines := strings.Split(src, "\n")
+	depth, inComment, fragColor := 0, false, false
+	for n, line := range lines {
+		if match := shaderDirectivePattern.FindStringSubmatch(line); match != nil && !inComment {
+			switch match[1] {
+			case "if", "ifdef", "ifndef":
+				depth++
+			case "endif":
+				depth--
+			case "extension":
+				// Moved lines are left empty to preserve lines numbering
+				if depth == 0 {
+					header.WriteString(strings.TrimSpace(line) + "\n")
+					lines[n] = ""
+					continue
+				}
+			}
+		}
+		lines[n], inComment = mapShaderCode(line, inComment, func(code string) string {
+			if ty == FRAGMENT_SHADER && shaderFragColorPattern.MatchString(code) {
+				fragColor = true
+				return shaderFragColorPattern.ReplaceAllString(code, shaderFragColorOutput)
+			}
+			return code
+		})
+	}
+	if strings.HasSuffix(version, " es") {
+		for _, precision := range shaderESPrecisions {
+			header.WriteString("precision " + precision + ";\n")
+		}
+	}
+	if fragColor {
+		header.WriteString("out vec4 " + shaderFragColorOutput + ";\n")
+	}
+	header.WriteString("#line 1\n")
+
+	return header.String() + strings.Join(lines, "\n")
+}
+
+// mapShaderCode applies fn to the parts of line outside comments, inComment
+// indicates if line starts in a block comment and the returned one if it ends
+// in a block comment
+func mapShaderCode(line string, inComment bool, fn func(string) string) (string, bool) {
+	var out strings.Builder
+	for line != "" {
+		if inComment {
+			end := strings.Index(line, "*/")
+			if end < 0 {
+				out.WriteString(line)
+				return out.String(), true
+			}
+			out.WriteString(line[:end+2])
+			line, inComment = line[end+2:], false
+			continue
+		}
+		lineComment, blockComment := strings.Index(line, "//"), strings.Index(line, "/*")
+		if lineComment >= 0 && (blockComment < 0 || lineComment < blockComment) {
+			out.WriteString(fn(line[:lineComment]) + line[lineComment:])
+			return out.String(), false
+		}
+		if blockComment < 0 {
+			out.WriteString(fn(line))
+			return out.String(), false
+		}
+		out.WriteString(fn(line[:blockComment]) + "/*")
+		line, inComment = line[blockComment+2:], true
+	}
+	return out.String(), inComment
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2019 Thomas MILLET. All rights reserved.
+package gl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPreprocessShader(t *testing.T) {
+	src := "#version 100\nvoid main() {\n\tgl_FragColor = vec4(1.0);\n}\n"
+
+	desktop := preprocessShader(FRAGMENT_SHADER, src, "330 core")
+	expected := "#version 330 core\nout vec4 tge_FragColor;\n#line 1\n\nvoid main() {\n\ttge_FragColor = vec4(1.0);\n}\n"
+	if desktop != expected {
+		t.Errorf("preprocessShader() = %q, expected %q", desktop, expected)
+	}
+
+	es := preprocessShader(FRAGMENT_SHADER, src, "300 es")
+	if !strings.HasPrefix(es, "#version 300 es\nprecision highp float;\n") || !strings.Contains(es, "precision mediump sampler3D;\n") {
+		t.Errorf("preprocessShader() = %q, expected ES precisions", es)
+	}
+
+	extension := preprocessShader(VERTEX_SHADER, "#extension GL_OVR_multiview2 : require\nvoid main() {}\n", "300 es")
+	if !strings.HasPrefix(extension, "#version 300 es\n#extension GL_OVR_multiview2 : require\nprecision ") || !strings.HasSuffix(extension, "#line 1\n\nvoid main() {}\n") {
+		t.Errorf("preprocessShader() = %q, expected #extension after #version", extension)
+	}
+
+	guarded := "#ifdef GL_OVR_multiview2\n#extension GL_OVR_multiview2 : enable\n#endif\nvoid main() {}\n"
+	if conditional := preprocessShader(VERTEX_SHADER, guarded, "300 es"); !strings.HasSuffix(conditional, "#line 1\n"+guarded) {
+		t.Errorf("preprocessShader() = %q, expected guarded #extension left in place", conditional)
+	}
+
+	comments := "/* gl_FragColor\ngl_FragColor */ float my_gl_FragColor; // gl_FragColor\nvoid main() { gl_FragColor = vec4(my_gl_FragColor); }\n"
+	expected = "/* gl_FragColor\ngl_FragColor */ float my_gl_FragColor; // gl_FragColor\nvoid main() { tge_FragColor = vec4(my_gl_FragColor); }\n"
+	if fragColor := preprocessShader(FRAGMENT_SHADER, comments, "330 core"); !strings.HasSuffix(fragColor, "#line 1\n"+expected) {
+		t.Errorf("preprocessShader() = %q, expected gl_FragColor only replaced in code", fragColor)
+	}
+	if noFragColor := preprocessShader(FRAGMENT_SHADER, "// gl_FragColor\nvoid main() {}\n", "330 core"); strings.Contains(noFragColor, "out vec4") {
+		t.Errorf("preprocessShader() = %q, expected no output declared for commented gl_FragColor", noFragColor)
+	}
+}
@@ -17,9 +17,18 @@ type Attrib int32
 // Program identifies a compiled shader program.
 type Program uint32
 
+// Query identifies a GL query object.
+type Query uint32
+
+// Sampler identifies a GL sampler object.
+type Sampler uint32
+
 // Shader identifies a GLSL shader.
 type Shader uint32
 
+// Sync identifies a GL fence sync object.
+type Sync uintptr
+
 // Buffer identifies a GL buffer object.
 type Buffer uint32
 
@@ -32,9 +41,15 @@ type Renderbuffer uint32
 // A Texture identifies a GL texture unit.
 type Texture uint32
 
+// A TransformFeedback identifies a GL transform feedback object.
+type TransformFeedback uint32
+
 // Uniform identifies the location of a specific uniform variable.
 type Uniform int32
 
+// UniformBlock identifies the index of a uniform block in a program.
+type UniformBlock uint32
+
 // A VertexArray is a GL object that holds vertices in an internal format.
 type VertexArray uint32
 
@@ -44,9 +59,18 @@ func (v Attrib) Valid() bool { return v >= 0 }
 // Valid indicates if program is valid in OpenGL context
 func (v Program) Valid() bool { return v > 0 }
 
+// Valid indicates if query is valid in OpenGL context
+func (v Query) Valid() bool { return v > 0 }
+
+// Valid indicates if sampler is valid in OpenGL context
+func (v Sampler) Valid() bool { return v > 0 }
+
 // Valid indicates if shader is valid in OpenGL context
 func (v Shader) Valid() bool { return v > 0 }
 
+// Valid indicates if sync is valid in OpenGL context
+func (v Sync) Valid() bool { return v > 0 }
+
 // Valid indicates if buffer is valid in OpenGL context
 func (v Buffer) Valid() bool { return v > 0 }
 
@@ -59,8 +83,14 @@ func (v Renderbuffer) Valid() bool { return v > 0 }
 // Valid indicates if texture is valid in OpenGL context
 func (v Texture) Valid() bool { return v > 0 }
 
+// Valid indicates if transform feedback is valid in OpenGL context
+func (v TransformFeedback) Valid() bool { return v > 0 }
+
 // Valid indicates if uniform is valid in OpenGL context
 func (v Uniform) Valid() bool { return v >= 0 }
 
+// Valid indicates if uniform block is valid in OpenGL context
+func (v UniformBlock) Valid() bool { return v != INVALID_INDEX }
+
 // Valid indicates if VAO is valid in OpenGL context
 func (v VertexArray) Valid() bool { return v > 0 }
@@ -0,0 +1,183 @@
+// Copyright (c) 2019 Thomas MILLET. All rights reserved.
+
+package gl
+
+import (
+	fmt "fmt"
+)
+
+// UniformSet sets the uniforms of a program by name. Locations and types are
+// retrieved once with Reflect and values are only uploaded when they differ
+// from the last ones set. As glUniform* calls, setters apply to the program in
+// use, UseProgram must then be called with the program of the set before.
+type UniformSet struct {
+	program  Program
+	uniforms map[string]*uniformValue
+}
+
+// uniformValue holds the location, type and last value of a uniform
+type uniformValue struct {
+	location Uniform
+	ty       Enum
+	set      bool
+	floats   [16]float32
+	integer  int32
+}
+
+// NewUniformSet creates the UniformSet of the linked program p
+func NewUniformSet(p Program) *UniformSet {
+	s := &UniformSet{
+		program:  p,
+		uniforms: make(map[string]*uniformValue),
+	}
+	for _, u := range Reflect(p).Uniforms {
+		if u.Location.Valid() {
+			s.uniforms[u.Name] = &uniformValue{location: u.Location, ty: u.Type}
+		}
+	}
+	return s
+}
+
+// Program returns the program of the set
+func (s *UniformSet) Program() Program {
+	return s.program
+}
+
+// Location returns the location of uniform name, Uniform(-1) if name is not an
+// active uniform of the default block
+func (s *UniformSet) Location(name string) Uniform {
+	if u, found := s.uniforms[name]; found {
+		return u.location
+	}
+	return Uniform(-1)
+}
+
+// Reset forgets the values set, next setters calls will upload their values
+func (s *UniformSet) Reset() {
+	for _, u := range s.uniforms {
+		u.set = false
+	}
+}
+
+// lookup returns the uniform name if its type is one of types
+func (s *UniformSet) lookup(name string, types ...Enum) (*uniformValue, error) {
+	u, found := s.uniforms[name]
+	if !found {
+		return nil, fmt.Errorf("Uniform %s not found", name)
+	}
+	for _, ty := range types {
+		if u.ty == ty {
+			return u, nil
+		}
+	}
+	return nil, fmt.Errorf("Uniform %s is a %s, not a %s", name, GLSLTypeName(u.ty), GLSLTypeName(types[0]))
+}
+
+// setFloats updates the value of u and indicates if it has changed
+func (u *uniformValue) setFloats(values ...float32) bool {
+	changed := !u.set
+	for i, v := range values {
+		if u.floats[i] != v {
+			u.floats[i] = v
+			changed = true
+		}
+	}
+	u.set = true
+	return changed
+}
+
+// SetFloat sets the value of a float uniform
+func (s *UniformSet) SetFloat(name string, v float32) error {
+	u, err := s.lookup(name, FLOAT)
+	if err != nil {
+		return err
+	}
+	if u.setFloats(v) {
+		Uniform1f(u.location, v)
+	}
+	return nil
+}
+
+// SetVec2 sets the value of a vec2 uniform
+func (s *UniformSet) SetVec2(name string, v0, v1 float32) error {
+	u, err := s.lookup(name, FLOAT_VEC2)
+	if err != nil {
+		return err
+	}
+	if u.setFloats(v0, v1) {
+		Uniform2f(u.location, v0, v1)
+	}
+	return nil
+}
+
+// SetVec3 sets the value of a vec3 uniform
+func (s *UniformSet) SetVec3(name string, v0, v1, v2 float32) error {
+	u, err := s.lookup(name, FLOAT_VEC3)
+	if err != nil {
+		return err
+	}
+	if u.setFloats(v0, v1, v2) {
+		Uniform3f(u.location, v0, v1, v2)
+	}
+	return nil
+}
+
+// SetVec4 sets the value of a vec4 uniform
+func (s *UniformSet) SetVec4(name string, v0, v1, v2, v3 float32) error {
+	u, err := s.lookup(name, FLOAT_VEC4)
+	if err != nil {
+		return err
+	}
+	if u.setFloats(v0, v1, v2, v3) {
+		Uniform4f(u.location, v0, v1, v2, v3)
+	}
+	return nil
+}
+
+// SetMat3 sets the value of a mat3 uniform from 9 floats in column major order
+func (s *UniformSet) SetMat3(name string, m []float32) error {
+	u, err := s.lookup(name, FLOAT_MAT3)
+	if err != nil {
+		return err
+	}
+	if len(m) < 9 {
+		return fmt.Errorf("Uniform %s needs 9 values, got %d", name, len(m))
+	}
+	if u.setFloats(m[:9]...) {
+		UniformMatrix3fvP(u.location, 1, false, &u.floats[0])
+	}
+	return nil
+}
+
+// SetMat4 sets the value of a mat4 uniform from 16 floats in column major order
+func (s *UniformSet) SetMat4(name string, m []float32) error {
+	u, err := s.lookup(name, FLOAT_MAT4)
+	if err != nil {
+		return err
+	}
+	if len(m) < 16 {
+		return fmt.Errorf("Uniform %s needs 16 values, got %d", name, len(m))
+	}
+	if u.setFloats(m[:16]...) {
+		UniformMatrix4fvP(u.location, 1, false, &u.floats[0])
+	}
+	return nil
+}
+
+// SetInt sets the value of an int, bool or sampler uniform, samplers values
+// are texture units indices
+func (s *UniformSet) SetInt(name string, v int32) error {
+	u, err := s.lookup(name, INT, BOOL,
+		SAMPLER_2D, SAMPLER_3D, SAMPLER_CUBE, SAMPLER_2D_SHADOW, SAMPLER_2D_ARRAY, SAMPLER_2D_ARRAY_SHADOW, SAMPLER_CUBE_SHADOW,
+		INT_SAMPLER_2D, INT_SAMPLER_3D, INT_SAMPLER_CUBE, INT_SAMPLER_2D_ARRAY,
+		UNSIGNED_INT_SAMPLER_2D, UNSIGNED_INT_SAMPLER_3D, UNSIGNED_INT_SAMPLER_CUBE, UNSIGNED_INT_SAMPLER_2D_ARRAY)
+	if err != nil {
+		return err
+	}
+	if !u.set || u.integer != v {
+		u.integer = v
+		u.set = true
+		Uniform1i(u.location, int(v))
+	}
+	return nil
+}